@@ -0,0 +1,149 @@
+// Package auth authenticates the callers of the accounts service. Users are authenticated by the access token the
+// proxy issues, other services by short lived tokens signed with the shared jwt secret and passed in the metadata of
+// their grpc requests.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/micro/go-micro/v2/client"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/micro/go-micro/v2/server"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/owncloud/ocis-pkg/v2/roles"
+)
+
+const (
+	// TokenMetadataKey is the metadata key of the service token
+	TokenMetadataKey = "X-Service-Token"
+
+	// audience keeps access tokens of users, which are signed with the same secret, from being accepted as service
+	// tokens
+	audience = "com.owncloud.api.accounts"
+
+	// tokenTTL is the lifetime of service tokens, a new token is issued for every request
+	tokenTTL = 5 * time.Minute
+
+	// UnauthenticatedService is the service name of the principal of requests without a service token, if they are
+	// allowed
+	UnauthenticatedService = "unauthenticated"
+)
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	// Service is the name of the calling service, it is empty when a user called the http api
+	Service string
+	// AccountID is the id of the user the request is made for
+	AccountID string
+	// RoleIDs are the roles of the user. A service calling without an account and roles acts on its own behalf.
+	RoleIDs []string
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying the principal
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of the context. It only holds one if the caller has been authenticated.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// NewToken returns a service token for the named service, signed with the secret
+func NewToken(secret, service string) (string, error) {
+	if secret == "" {
+		return "", errors.New("no jwt secret configured")
+	}
+	now := time.Now()
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
+		Audience:  audience,
+		Subject:   service,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(tokenTTL).Unix(),
+	})
+	return t.SignedString([]byte(secret))
+}
+
+// ParseToken verifies a service token and returns the name of the service it has been issued for
+func ParseToken(secret, token string) (string, error) {
+	if secret == "" {
+		return "", errors.New("no jwt secret configured")
+	}
+	claims := &jwt.StandardClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+	switch {
+	case err != nil:
+		return "", err
+	case !claims.VerifyAudience(audience, true):
+		return "", errors.New("token is not a service token")
+	case !claims.VerifyExpiresAt(time.Now().Unix(), true):
+		return "", errors.New("token has no expiry")
+	case claims.Subject == "":
+		return "", errors.New("token names no service")
+	}
+	return claims.Subject, nil
+}
+
+// ClientWrapper returns a go-micro client wrapper that authenticates every call as the named service
+func ClientWrapper(secret, service string) client.Wrapper {
+	return func(c client.Client) client.Client {
+		return &tokenClient{Client: c, secret: secret, service: service}
+	}
+}
+
+type tokenClient struct {
+	client.Client
+	secret  string
+	service string
+}
+
+// Call adds a fresh service token to the metadata of the request
+func (c *tokenClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	token, err := NewToken(c.secret, c.service)
+	if err != nil {
+		return err
+	}
+	return c.Client.Call(metadata.Set(ctx, TokenMetadataKey, token), req, rsp, opts...)
+}
+
+// HandlerWrapper returns a go-micro handler wrapper that authenticates services by the token in the request metadata.
+// The account and roles in the metadata are only trusted when a service with a valid token passes them. Requests with
+// an invalid token are rejected. Requests without a token are passed on without a principal, unless unauthenticated
+// requests are allowed for services that do not send tokens yet. They are then trusted like services, but never on
+// behalf of the account and roles in their metadata.
+func HandlerWrapper(secret string, allowUnauthenticated bool) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			token, ok := metadata.Get(ctx, TokenMetadataKey)
+			if !ok {
+				if !allowUnauthenticated {
+					return fn(ctx, req, rsp)
+				}
+				return fn(NewContext(ctx, Principal{Service: UnauthenticatedService}), req, rsp)
+			}
+			service, err := ParseToken(secret, token)
+			if err != nil {
+				return merrors.Unauthorized(req.Service(), "invalid service token: %v", err.Error())
+			}
+
+			// a user without roles must not get the permissions of the service
+			p := Principal{Service: service}
+			p.RoleIDs, _ = roles.ReadRoleIDsFromContext(ctx)
+			p.AccountID, _ = metadata.Get(ctx, middleware.AccountID)
+			return fn(NewContext(ctx, p), req, rsp)
+		}
+	}
+}
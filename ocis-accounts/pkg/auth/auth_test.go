@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/micro/go-micro/v2/server"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	token, err := NewToken("secret", "proxy")
+	require.NoError(t, err)
+
+	service, err := ParseToken("secret", token)
+	require.NoError(t, err)
+	assert.Equal(t, "proxy", service)
+
+	_, err = ParseToken("other", token)
+	assert.Error(t, err)
+	_, err = ParseToken("", token)
+	assert.Error(t, err)
+	_, err = NewToken("", "proxy")
+	assert.Error(t, err)
+
+	sign := func(claims jwt.StandardClaims) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		return s
+	}
+	exp := time.Now().Add(time.Minute).Unix()
+
+	// access tokens of users are signed with the same secret but have no service audience
+	_, err = ParseToken("secret", sign(jwt.StandardClaims{Subject: "einstein", ExpiresAt: exp}))
+	assert.Error(t, err)
+	_, err = ParseToken("secret", sign(jwt.StandardClaims{Audience: audience, Subject: "proxy"}))
+	assert.Error(t, err, "tokens must expire")
+	_, err = ParseToken("secret", sign(jwt.StandardClaims{Audience: audience, Subject: "proxy", ExpiresAt: time.Now().Add(-time.Minute).Unix()}))
+	assert.Error(t, err)
+	_, err = ParseToken("secret", sign(jwt.StandardClaims{Audience: audience, ExpiresAt: exp}))
+	assert.Error(t, err)
+
+	none, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.StandardClaims{Audience: audience, Subject: "proxy", ExpiresAt: exp}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	_, err = ParseToken("secret", none)
+	assert.Error(t, err)
+}
+
+func TestPrincipal(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	p, ok := FromContext(NewContext(context.Background(), Principal{Service: "proxy", AccountID: "einstein", RoleIDs: []string{"admin"}}))
+	require.True(t, ok)
+	assert.Equal(t, "proxy", p.Service)
+	assert.Equal(t, "einstein", p.AccountID)
+	assert.Equal(t, []string{"admin"}, p.RoleIDs)
+}
+
+func TestHandlerWrapper(t *testing.T) {
+	call := func(ctx context.Context, allowUnauthenticated bool) (Principal, bool) {
+		var p Principal
+		var ok bool
+		err := HandlerWrapper("secret", allowUnauthenticated)(func(ctx context.Context, req server.Request, rsp interface{}) error {
+			p, ok = FromContext(ctx)
+			return nil
+		})(ctx, nil, nil)
+		require.NoError(t, err)
+		return p, ok
+	}
+
+	_, ok := call(context.Background(), false)
+	assert.False(t, ok)
+
+	token, err := NewToken("secret", "proxy")
+	require.NoError(t, err)
+	p, ok := call(metadata.Set(context.Background(), TokenMetadataKey, token), false)
+	require.True(t, ok)
+	assert.Equal(t, Principal{Service: "proxy"}, p)
+
+	ctx := metadata.Set(context.Background(), middleware.AccountID, "einstein")
+	ctx = metadata.Set(ctx, middleware.RoleIDs, `["admin"]`)
+	p, ok = call(metadata.Set(ctx, TokenMetadataKey, token), false)
+	require.True(t, ok)
+	assert.Equal(t, Principal{Service: "proxy", AccountID: "einstein", RoleIDs: []string{"admin"}}, p)
+
+	// the account and roles are not trusted without a token
+	_, ok = call(ctx, false)
+	assert.False(t, ok)
+
+	// services that do not send a token yet are trusted if allowed, but not on behalf of a user
+	p, ok = call(context.Background(), true)
+	require.True(t, ok)
+	assert.Equal(t, Principal{Service: UnauthenticatedService}, p)
+	p, ok = call(ctx, true)
+	require.True(t, ok)
+	assert.Equal(t, Principal{Service: UnauthenticatedService}, p)
+}
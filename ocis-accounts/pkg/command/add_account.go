@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/micro/cli/v2"
-	"github.com/micro/go-micro/v2/client/grpc"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
 	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
@@ -37,12 +36,11 @@ func AddAccount(cfg *config.Config) *cli.Command {
 				}
 			}
 
-			return nil
-
+			return parseSchedule(c, a)
 		},
 		Action: func(c *cli.Context) error {
 			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
-			accSvc := accounts.NewAccountsService(accSvcID, grpc.NewClient())
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
 			_, err := accSvc.CreateAccount(c.Context, &accounts.CreateAccountRequest{
 				Account: a,
 			})
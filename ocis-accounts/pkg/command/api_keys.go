@@ -0,0 +1,187 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/micro/cli/v2"
+	tw "github.com/olekukonko/tablewriter"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// APIKeys command manages the api keys of service accounts.
+func APIKeys(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "api-keys",
+		Usage: "Manage the api keys of service accounts",
+		Subcommands: []*cli.Command{
+			ListAPIKeys(cfg),
+			CreateAPIKey(cfg),
+			RotateAPIKey(cfg),
+			RevokeAPIKey(cfg),
+		},
+	}
+}
+
+// ListAPIKeys command lists the api keys of a service account.
+func ListAPIKeys(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "list",
+		Usage:     "List the api keys of a service account",
+		ArgsUsage: "account-id",
+		Aliases:   []string{"ls"},
+		Flags:     flagset.APIKeysWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 1 {
+				fmt.Println("Please provide an account-id")
+				os.Exit(1)
+			}
+
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			acc, err := accSvc.GetAccount(c.Context, &accounts.GetAccountRequest{Id: c.Args().First()})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not list api keys %w", err))
+				return err
+			}
+
+			buildAPIKeysListTable(acc.ApiKeys).Render()
+			return nil
+		}}
+}
+
+// CreateAPIKey command creates an api key for a service account.
+func CreateAPIKey(cfg *config.Config) *cli.Command {
+	req := &accounts.CreateApiKeyRequest{}
+	var validFor time.Duration
+	return &cli.Command{
+		Name:      "create",
+		Usage:     "Create an api key for a service account",
+		ArgsUsage: "account-id",
+		Aliases:   []string{"add", "a"},
+		Flags:     flagset.CreateAPIKeyWithConfig(cfg, req, &validFor),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 1 {
+				fmt.Println("Please provide an account-id")
+				os.Exit(1)
+			}
+
+			req.AccountId = c.Args().First()
+			req.ExpirationDateTime = expiration(validFor)
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			resp, err := accSvc.CreateApiKey(c.Context, req)
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not create api key %w", err))
+				return err
+			}
+
+			printAPIKey(resp)
+			return nil
+		}}
+}
+
+// RotateAPIKey command replaces the secret of an api key.
+func RotateAPIKey(cfg *config.Config) *cli.Command {
+	var validFor time.Duration
+	return &cli.Command{
+		Name:      "rotate",
+		Usage:     "Replace the secret of an api key, the previous secret is no longer valid",
+		ArgsUsage: "account-id key-id",
+		Flags:     flagset.RotateAPIKeyWithConfig(cfg, &validFor),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 2 {
+				fmt.Println("Please provide an account-id and a key-id")
+				os.Exit(1)
+			}
+
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			resp, err := accSvc.RotateApiKey(c.Context, &accounts.RotateApiKeyRequest{
+				AccountId:          c.Args().Get(0),
+				Id:                 c.Args().Get(1),
+				ExpirationDateTime: expiration(validFor),
+			})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not rotate api key %w", err))
+				return err
+			}
+
+			printAPIKey(resp)
+			return nil
+		}}
+}
+
+// RevokeAPIKey command revokes an api key.
+func RevokeAPIKey(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "revoke",
+		Usage:     "Revoke an api key",
+		ArgsUsage: "account-id key-id",
+		Aliases:   []string{"rm"},
+		Flags:     flagset.APIKeysWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 2 {
+				fmt.Println("Please provide an account-id and a key-id")
+				os.Exit(1)
+			}
+
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			_, err := accSvc.RevokeApiKey(c.Context, &accounts.RevokeApiKeyRequest{
+				AccountId: c.Args().Get(0),
+				Id:        c.Args().Get(1),
+			})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not revoke api key %w", err))
+				return err
+			}
+
+			return nil
+		}}
+}
+
+// expiration returns the expiration of a key that is valid for the given duration, zero durations do not expire
+func expiration(validFor time.Duration) *timestamppb.Timestamp {
+	if validFor <= 0 {
+		return nil
+	}
+	return timestamppb.New(time.Now().Add(validFor))
+}
+
+// printAPIKey prints a created or rotated key, it can not be shown again
+func printAPIKey(resp *accounts.ApiKeySecret) {
+	fmt.Printf("Id:  %s\nKey: %s\n\nStore the key now, it will not be shown again.\n", resp.ApiKey.Id, resp.Key)
+}
+
+// buildAPIKeysListTable creates an ascii table for printing on the cli
+func buildAPIKeysListTable(keys []*accounts.ApiKey) *tw.Table {
+	table := tw.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Id", "Name", "CreatedDateTime", "ExpirationDateTime", "LastUsedDateTime"})
+	table.SetAutoFormatHeaders(false)
+	for _, k := range keys {
+		table.Append([]string{
+			k.Id,
+			k.Name,
+			formatTimestamp(k.CreatedDateTime),
+			formatTimestamp(k.ExpirationDateTime),
+			formatTimestamp(k.LastUsedDateTime)})
+	}
+	return table
+}
+
+// formatTimestamp formats a timestamp for the cli, unset timestamps are empty
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/micro/cli/v2"
+	tw "github.com/olekukonko/tablewriter"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// Check command reports accounts and groups that violate the uniqueness constraints.
+func Check(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "check",
+		Usage: "Report accounts and groups sharing names, mail addresses or numbers",
+		Flags: flagset.CheckWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			duplicates := map[string]map[string][]string{}
+			add := func(kind, property, value, id string) {
+				if value == "" || value == "0" {
+					return
+				}
+				key := kind + " " + property
+				if duplicates[key] == nil {
+					duplicates[key] = map[string][]string{}
+				}
+				value = strings.ToLower(value)
+				duplicates[key][value] = append(duplicates[key][value], id)
+			}
+
+			err := readRecords(filepath.Join(cfg.Server.AccountsDataPath, "accounts"), func() interface{} { return &accounts.Account{} }, func(r interface{}) {
+				a := r.(*accounts.Account)
+				add("account", "preferred_name", a.PreferredName, a.Id)
+				add("account", "on_premises_sam_account_name", a.OnPremisesSamAccountName, a.Id)
+				add("account", "mail", a.Mail, a.Id)
+				add("account", "uid_number", strconv.FormatInt(a.UidNumber, 10), a.Id)
+			})
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not check accounts %w", err))
+				return err
+			}
+
+			err = readRecords(filepath.Join(cfg.Server.AccountsDataPath, "groups"), func() interface{} { return &accounts.Group{} }, func(r interface{}) {
+				g := r.(*accounts.Group)
+				add("group", "on_premises_sam_account_name", g.OnPremisesSamAccountName, g.Id)
+				add("group", "gid_number", strconv.FormatInt(g.GidNumber, 10), g.Id)
+			})
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not check groups %w", err))
+				return err
+			}
+
+			rows := [][]string{}
+			for key, values := range duplicates {
+				for value, ids := range values {
+					if len(ids) > 1 {
+						sort.Strings(ids)
+						rows = append(rows, []string{key, value, strings.Join(ids, ", ")})
+					}
+				}
+			}
+
+			if len(rows) == 0 {
+				fmt.Println("No duplicates found")
+				return nil
+			}
+
+			sort.Slice(rows, func(i, j int) bool {
+				if rows[i][0] != rows[j][0] {
+					return rows[i][0] < rows[j][0]
+				}
+				return rows[i][1] < rows[j][1]
+			})
+			table := tw.NewWriter(os.Stdout)
+			table.SetHeader([]string{"Property", "Value", "Ids"})
+			table.SetAutoFormatHeaders(false)
+			table.AppendBulk(rows)
+			table.Render()
+
+			return cli.Exit(fmt.Sprintf("found %d duplicate values", len(rows)), 1)
+		}}
+}
+
+// readRecords unmarshals all files in dir and passes them to fn. A missing dir contains no records.
+func readRecords(dir string, newRecord func() interface{}, fn func(interface{})) error {
+	list, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, file := range list {
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return err
+		}
+		r := newRecord()
+		if err := json.Unmarshal(data, r); err != nil {
+			return fmt.Errorf("could not unmarshal %s: %w", file.Name(), err)
+		}
+		fn(r)
+	}
+	return nil
+}
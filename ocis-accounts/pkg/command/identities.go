@@ -0,0 +1,117 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/micro/cli/v2"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// Identities command manages the external identities linked to accounts.
+func Identities(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "identities",
+		Usage: "Manage the external identities linked to accounts",
+		Subcommands: []*cli.Command{
+			LinkIdentity(cfg),
+			UnlinkIdentity(cfg),
+			ResolveIdentity(cfg),
+		},
+	}
+}
+
+// LinkIdentity command links an external identity to an account.
+func LinkIdentity(cfg *config.Config) *cli.Command {
+	identity := &accounts.Identities{}
+	return &cli.Command{
+		Name:      "link",
+		Usage:     "Link an external identity to an account",
+		ArgsUsage: "account-id issuer issuer-assigned-id",
+		Flags:     flagset.LinkIdentityWithConfig(cfg, identity),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 3 {
+				fmt.Println("Please provide an account-id, an issuer and an issuer-assigned-id")
+				os.Exit(1)
+			}
+
+			identity.Issuer = c.Args().Get(1)
+			identity.IssuerAssignedId = c.Args().Get(2)
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			_, err := accSvc.LinkIdentity(c.Context, &accounts.LinkIdentityRequest{
+				AccountId: c.Args().Get(0),
+				Identity:  identity,
+			})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not link identity %w", err))
+				return err
+			}
+
+			return nil
+		}}
+}
+
+// UnlinkIdentity command removes an external identity from an account.
+func UnlinkIdentity(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "unlink",
+		Usage:     "Remove an external identity from an account",
+		ArgsUsage: "account-id issuer issuer-assigned-id",
+		Aliases:   []string{"rm"},
+		Flags:     flagset.IdentitiesWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 3 {
+				fmt.Println("Please provide an account-id, an issuer and an issuer-assigned-id")
+				os.Exit(1)
+			}
+
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			_, err := accSvc.UnlinkIdentity(c.Context, &accounts.UnlinkIdentityRequest{
+				AccountId:        c.Args().Get(0),
+				Issuer:           c.Args().Get(1),
+				IssuerAssignedId: c.Args().Get(2),
+			})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not unlink identity %w", err))
+				return err
+			}
+
+			return nil
+		}}
+}
+
+// ResolveIdentity command shows the account an external identity is linked to.
+func ResolveIdentity(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "resolve",
+		Usage:     "Show the account an external identity is linked to",
+		ArgsUsage: "issuer issuer-assigned-id",
+		Flags:     flagset.IdentitiesWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 2 {
+				fmt.Println("Please provide an issuer and an issuer-assigned-id")
+				os.Exit(1)
+			}
+
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			acc, err := accSvc.ResolveIdentity(c.Context, &accounts.ResolveIdentityRequest{
+				Issuer:           c.Args().Get(0),
+				IssuerAssignedId: c.Args().Get(1),
+			})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not resolve identity %w", err))
+				return err
+			}
+
+			buildAccountInspectTable(acc).Render()
+			return nil
+		}}
+}
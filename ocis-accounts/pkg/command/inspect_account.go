@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"github.com/micro/cli/v2"
-	"github.com/micro/go-micro/v2/client/grpc"
 	tw "github.com/olekukonko/tablewriter"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
@@ -28,7 +27,7 @@ func InspectAccount(cfg *config.Config) *cli.Command {
 			}
 
 			uid := c.Args().First()
-			accSvc := accounts.NewAccountsService(accServiceID, grpc.NewClient())
+			accSvc := accounts.NewAccountsService(accServiceID, newClient(cfg))
 			acc, err := accSvc.GetAccount(c.Context, &accounts.GetAccountRequest{
 				Id: uid,
 			})
@@ -54,11 +53,20 @@ func buildAccountInspectTable(acc *accounts.Account) *tw.Table {
 		{"AccountEnabled", strconv.FormatBool(acc.AccountEnabled)},
 		{"CreationType", acc.CreationType},
 		{"CreatedDateTime", acc.CreatedDateTime.String()},
+		{"LastModifiedDateTime", acc.LastModifiedDateTime.String()},
+		{"LastSignInDateTime", acc.LastSignInDateTime.String()},
+		{"SignInSessionsValidFromDateTime", acc.SignInSessionsValidFromDateTime.String()},
+		{"ExpirationDateTime", acc.ExpirationDateTime.String()},
+		{"ScheduledEnableDateTime", acc.ScheduledEnableDateTime.String()},
 		{"Description", acc.Description},
+		{"PreferredLanguage", acc.PreferredLanguage},
 		{"ExternalUserState", acc.ExternalUserState},
+		{"InvitationExpirationDateTime", acc.Invitation.GetExpirationDateTime().String()},
 		{"UidNumber", fmt.Sprintf("%+d", acc.UidNumber)},
 		{"GidNumber", fmt.Sprintf("%+d", acc.GidNumber)},
 		{"IsResourceAccount", strconv.FormatBool(acc.IsResourceAccount)},
+		{"IsServiceAccount", strconv.FormatBool(acc.IsServiceAccount)},
+		{"UserType", acc.UserType},
 		{"OnPremisesDistinguishedName", acc.OnPremisesDistinguishedName},
 		{"OnPremisesDomainName", acc.OnPremisesDomainName},
 		{"OnPremisesImmutableId", acc.OnPremisesImmutableId},
@@ -73,5 +81,15 @@ func buildAccountInspectTable(acc *accounts.Account) *tw.Table {
 		table.Append([]string{"MemberOf", acc.MemberOf[k].DisplayName})
 	}
 
+	// Merged cell with identities
+	for k := range acc.Identities {
+		table.Append([]string{"Identities", acc.Identities[k].IssuerAssignedId + " (" + acc.Identities[k].Issuer + ")"})
+	}
+
+	// Merged cell with api keys
+	for k := range acc.ApiKeys {
+		table.Append([]string{"ApiKeys", acc.ApiKeys[k].Name + " (" + acc.ApiKeys[k].Id + ")"})
+	}
+
 	return table
 }
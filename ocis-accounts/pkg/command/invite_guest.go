@@ -0,0 +1,44 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/micro/cli/v2"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// InviteGuest command invites an external user as a guest.
+func InviteGuest(cfg *config.Config) *cli.Command {
+	req := &accounts.InviteGuestRequest{}
+	var validFor time.Duration
+	return &cli.Command{
+		Name:      "invite",
+		Usage:     "Invite an external user as a guest",
+		ArgsUsage: "mail",
+		Flags:     flagset.InviteGuestWithConfig(cfg, req, &validFor),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 1 {
+				fmt.Println("Please provide a mail address")
+				os.Exit(1)
+			}
+
+			req.Mail = c.Args().First()
+			req.ExpirationDateTime = expiration(validFor)
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			resp, err := accSvc.InviteGuest(c.Context, req)
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not invite guest %w", err))
+				return err
+			}
+
+			fmt.Printf("Id:      %s\nToken:   %s\nExpires: %s\n\nPass the token to the guest now, it will not be shown again.\n",
+				resp.InvitedUser.Id, resp.RedemptionToken, formatTimestamp(resp.ExpirationDateTime))
+			return nil
+		}}
+}
@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/micro/cli/v2"
-	"github.com/micro/go-micro/v2/client/grpc"
 	tw "github.com/olekukonko/tablewriter"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
@@ -15,15 +15,23 @@ import (
 
 // ListAccounts command lists all accounts
 func ListAccounts(cfg *config.Config) *cli.Command {
+	req := &accounts.ListAccountsRequest{}
 	return &cli.Command{
 		Name:    "list",
 		Usage:   "List existing accounts",
 		Aliases: []string{"ls"},
-		Flags:   flagset.ListAccountsWithConfig(cfg),
+		Flags:   flagset.ListAccountsWithConfig(cfg, req),
 		Action: func(c *cli.Context) error {
 			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
-			accSvc := accounts.NewAccountsService(accSvcID, grpc.NewClient())
-			resp, err := accSvc.ListAccounts(c.Context, &accounts.ListAccountsRequest{})
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			if d := c.Duration("expiring-within"); d > 0 {
+				expiring := fmt.Sprintf("expiration_date_time lt %s", time.Now().Add(d).UTC().Format(time.RFC3339))
+				if req.Query != "" {
+					expiring = fmt.Sprintf("(%s) and %s", req.Query, expiring)
+				}
+				req.Query = expiring
+			}
+			resp, err := accSvc.ListAccounts(c.Context, req)
 
 			if err != nil {
 				fmt.Println(fmt.Errorf("could not list accounts %w", err))
@@ -38,14 +46,15 @@ func ListAccounts(cfg *config.Config) *cli.Command {
 // buildAccountsListTable creates an ascii table for printing on the cli
 func buildAccountsListTable(accs []*accounts.Account) *tw.Table {
 	table := tw.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Id", "DisplayName", "Mail", "AccountEnabled"})
+	table.SetHeader([]string{"Id", "DisplayName", "Mail", "AccountEnabled", "ExpirationDateTime"})
 	table.SetAutoFormatHeaders(false)
 	for _, acc := range accs {
 		table.Append([]string{
 			acc.Id,
 			acc.DisplayName,
 			acc.Mail,
-			strconv.FormatBool(acc.AccountEnabled)})
+			strconv.FormatBool(acc.AccountEnabled),
+			formatTimestamp(acc.ExpirationDateTime)})
 	}
 	return table
 }
@@ -5,7 +5,6 @@ import (
 	"os"
 
 	"github.com/micro/cli/v2"
-	"github.com/micro/go-micro/v2/client/grpc"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
 	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
@@ -27,7 +26,7 @@ func RemoveAccount(cfg *config.Config) *cli.Command {
 			}
 
 			uid := c.Args().First()
-			accSvc := accounts.NewAccountsService(accServiceID, grpc.NewClient())
+			accSvc := accounts.NewAccountsService(accServiceID, newClient(cfg))
 			_, err := accSvc.DeleteAccount(c.Context, &accounts.DeleteAccountRequest{Id: uid})
 
 			if err != nil {
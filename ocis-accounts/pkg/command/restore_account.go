@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/micro/cli/v2"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// RestoreAccount command restores a deleted account.
+func RestoreAccount(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "restore",
+		Usage:     "Restores a deleted account",
+		ArgsUsage: "id",
+		Flags:     flagset.RestoreAccountWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			accServiceID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 1 {
+				fmt.Println("Please provide a user-id")
+				os.Exit(1)
+			}
+
+			uid := c.Args().First()
+			accSvc := accounts.NewAccountsService(accServiceID, newClient(cfg))
+			_, err := accSvc.RestoreAccount(c.Context, &accounts.RestoreAccountRequest{Id: uid})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not restore account %w", err))
+				return err
+			}
+
+			return nil
+		}}
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/micro/cli/v2"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// RevokeSessions command signs an account out of all sessions.
+func RevokeSessions(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "revoke-sessions",
+		Usage:     "Revoke all sessions and refresh tokens of an account",
+		ArgsUsage: "id",
+		Flags:     flagset.RevokeSessionsWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			accServiceID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			if c.NArg() != 1 {
+				fmt.Println("Please provide a user-id")
+				os.Exit(1)
+			}
+
+			uid := c.Args().First()
+			accSvc := accounts.NewAccountsService(accServiceID, newClient(cfg))
+			_, err := accSvc.RevokeSessions(c.Context, &accounts.RevokeSessionsRequest{Id: uid})
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not revoke sessions %w", err))
+				return err
+			}
+
+			return nil
+		}}
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
 
 	"github.com/micro/cli/v2"
+	"github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-micro/v2/client/grpc"
 	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/version"
 	"github.com/spf13/viper"
@@ -47,6 +50,13 @@ func Execute() error {
 			ListAccounts(cfg),
 			InspectAccount(cfg),
 			RemoveAccount(cfg),
+			RestoreAccount(cfg),
+			Check(cfg),
+			Sync(cfg),
+			APIKeys(cfg),
+			RevokeSessions(cfg),
+			Identities(cfg),
+			InviteGuest(cfg),
 		},
 	}
 
@@ -73,6 +83,11 @@ func NewLogger(cfg *config.Config) log.Logger {
 	)
 }
 
+// newClient returns a grpc client that authenticates the commands as a service
+func newClient(cfg *config.Config) client.Client {
+	return auth.ClientWrapper(cfg.TokenManager.JWTSecret, "accounts-cli")(grpc.NewClient())
+}
+
 // ParseConfig loads accounts configuration from Viper known paths.
 func ParseConfig(c *cli.Context, cfg *config.Config) error {
 	logger := NewLogger(cfg)
@@ -6,12 +6,17 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/micro/cli/v2"
+	mclient "github.com/micro/go-micro/v2/client"
 	"github.com/oklog/run"
+	"github.com/owncloud/ocis-pkg/v2/roles"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/metrics"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/server/debug"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/server/grpc"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/server/http"
 	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
@@ -41,10 +46,54 @@ func Server(cfg *config.Config) *cli.Command {
 				gr          = run.Group{}
 				ctx, cancel = context.WithCancel(context.Background())
 				mtrcs       = metrics.New()
+				readiness   = debug.NewReadiness("http index", "grpc index", "settings bundles", "permissions")
+				// TODO this won't work with a registry other than mdns. Look into Micro's client initialization.
+				// https://github.com/owncloud/ocis-proxy/issues/38
+				roleService = settings.NewRoleService("com.owncloud.api.settings", mclient.DefaultClient)
+				roleManager = roles.NewManager(
+					roles.CacheSize(1024),
+					roles.CacheTTL(time.Hour*24*7),
+					roles.Logger(logger),
+					roles.RoleService(roleService),
+				)
 			)
 
 			defer cancel()
 
+			{
+				server, err := debug.Server(
+					debug.Logger(logger),
+					debug.Config(cfg),
+					debug.Ready(readiness),
+				)
+				if err != nil {
+					logger.Error().Err(err).Str("server", "debug").Msg("Failed to initialize server")
+					return err
+				}
+
+				// the debug server is started right away, so it can report the readiness while the indexes are built
+				errs := make(chan error, 1)
+				go func() {
+					errs <- server.ListenAndServe()
+				}()
+
+				gr.Add(func() error {
+					return <-errs
+				}, func(_ error) {
+					logger.Info().
+						Str("server", "debug").
+						Msg("Shutting down server")
+
+					ctx, timeout := context.WithTimeout(context.Background(), 5*time.Second)
+					defer timeout()
+					if err := server.Shutdown(ctx); err != nil {
+						logger.Error().Err(err).Str("server", "debug").Msg("Failed to shutdown server")
+					}
+
+					cancel()
+				})
+			}
+
 			{
 				server := http.Server(
 					http.Logger(logger),
@@ -52,9 +101,12 @@ func Server(cfg *config.Config) *cli.Command {
 					http.Context(ctx),
 					http.Config(cfg),
 					http.Metrics(mtrcs),
+					http.RoleService(roleService),
+					http.RoleManager(&roleManager),
 					http.Flags(flagset.RootWithConfig(cfg)),
 					http.Flags(flagset.ServerWithConfig(cfg)),
 				)
+				readiness.Done("http index")
 
 				gr.Add(server.Run, func(_ error) {
 					logger.Info().
@@ -72,12 +124,15 @@ func Server(cfg *config.Config) *cli.Command {
 					grpc.Context(ctx),
 					grpc.Config(cfg),
 					grpc.Metrics(mtrcs),
+					grpc.RoleService(roleService),
+					grpc.RoleManager(&roleManager),
 				)
+				readiness.Done("grpc index")
 
 				gr.Add(func() error {
 					logger.Info().Str("service", server.Name()).Msg("Reporting settings bundles to settings service")
-					go svc.RegisterSettingsBundles(&logger)
-					go svc.RegisterPermissions(&logger)
+					go register(ctx, readiness, "settings bundles", func() error { return svc.RegisterSettingsBundles(&logger) })
+					go register(ctx, readiness, "permissions", func() error { return svc.RegisterPermissions(&logger) })
 					return server.Run()
 				}, func(_ error) {
 					logger.Info().
@@ -107,3 +162,19 @@ func Server(cfg *config.Config) *cli.Command {
 		},
 	}
 }
+
+// register repeats a registration with the settings service until it succeeds, the settings service may not be
+// available yet. The service is not ready before.
+func register(ctx context.Context, readiness *debug.Readiness, condition string, fn func() error) {
+	for {
+		if err := fn(); err == nil {
+			readiness.Done(condition)
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
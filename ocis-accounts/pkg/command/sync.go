@@ -0,0 +1,56 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/micro/cli/v2"
+	tw "github.com/olekukonko/tablewriter"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
+	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// Sync command imports accounts and groups from ldap.
+func Sync(cfg *config.Config) *cli.Command {
+	req := &accounts.SyncAccountsRequest{}
+	return &cli.Command{
+		Name:  "sync",
+		Usage: "Synchronise accounts and groups from LDAP",
+		Flags: flagset.SyncWithConfig(cfg, req),
+		Action: func(c *cli.Context) error {
+			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
+			resp, err := accSvc.SyncAccounts(c.Context, req)
+
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not synchronise accounts %w", err))
+				return err
+			}
+
+			if len(resp.Changes) == 0 {
+				fmt.Println("Nothing to synchronise")
+				return nil
+			}
+			buildSyncChangesTable(resp.Changes).Render()
+			return nil
+		}}
+}
+
+// buildSyncChangesTable creates an ascii table for printing on the cli
+func buildSyncChangesTable(changes []*accounts.SyncChange) *tw.Table {
+	table := tw.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Kind", "Action", "Id", "Name", "Properties", "Error"})
+	table.SetAutoFormatHeaders(false)
+	for _, c := range changes {
+		table.Append([]string{
+			c.Kind,
+			c.Action,
+			c.Id,
+			c.Name,
+			strings.Join(c.Properties, ", "),
+			c.Error})
+	}
+	return table
+}
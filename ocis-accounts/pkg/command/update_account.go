@@ -3,13 +3,14 @@ package command
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/micro/cli/v2"
-	"github.com/micro/go-micro/v2/client/grpc"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/flagset"
 	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // UpdateAccount command for modifying accounts including password policies
@@ -28,6 +29,10 @@ func UpdateAccount(cfg *config.Config) *cli.Command {
 				a.PasswordProfile.PasswordPolicies = c.StringSlice("password_policies")
 			}
 
+			if err := parseSchedule(c, a); err != nil {
+				return err
+			}
+
 			if c.NArg() != 1 {
 				return errors.New("missing account-id")
 			}
@@ -41,7 +46,7 @@ func UpdateAccount(cfg *config.Config) *cli.Command {
 		Action: func(c *cli.Context) error {
 			a.Id = c.Args().First()
 			accSvcID := cfg.GRPC.Namespace + "." + cfg.Server.Name
-			accSvc := accounts.NewAccountsService(accSvcID, grpc.NewClient())
+			accSvc := accounts.NewAccountsService(accSvcID, newClient(cfg))
 			_, err := accSvc.UpdateAccount(c.Context, &accounts.UpdateAccountRequest{
 				Account:    a,
 				UpdateMask: buildAccUpdateMask(c.FlagNames()),
@@ -69,11 +74,14 @@ func buildAccUpdateMask(setFlags []string) *field_mask.FieldMask {
 		"gidnumber":                    "GidNumber",
 		"mail":                         "Mail",
 		"description":                  "Description",
+		"preferred-language":           "PreferredLanguage",
 		"password":                     "PasswordProfile.Password",
 		"password-policies":            "PasswordProfile.PasswordPolicies",
 		"force-password-change":        "PasswordProfile.ForceChangePasswordNextSignIn",
 		"force-password-change-mfa":    "PasswordProfile.ForceChangePasswordNextSignInWithMfa",
 		"on-premises-sam-account-name": "OnPremisesSamAccountName",
+		"expiration":                   "ExpirationDateTime",
+		"scheduled-enable":             "ScheduledEnableDateTime",
 	}
 
 	updatedPaths := make([]string, 0)
@@ -86,3 +94,21 @@ func buildAccUpdateMask(setFlags []string) *field_mask.FieldMask {
 
 	return &field_mask.FieldMask{Paths: updatedPaths}
 }
+
+// parseSchedule sets the expiration and scheduled enable date times of the account from the RFC3339 flag values
+func parseSchedule(c *cli.Context, a *accounts.Account) error {
+	for name, ts := range map[string]**timestamppb.Timestamp{
+		"expiration":       &a.ExpirationDateTime,
+		"scheduled-enable": &a.ScheduledEnableDateTime,
+	} {
+		if !c.IsSet(name) || c.String(name) == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, c.String(name))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		*ts = timestamppb.New(t)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 // Package config should be moved to internal
 package config
 
+import "time"
+
 // LDAP defines the available ldap configuration.
 type LDAP struct {
 	Hostname     string
@@ -10,8 +12,12 @@ type LDAP struct {
 	GroupFilter  string
 	BindDN       string
 	BindPassword string
+	TLS          string
+	Insecure     bool
 	IDP          string
 	Schema       LDAPSchema
+	Sync         bool
+	SyncInterval time.Duration
 }
 
 // LDAPSchema defines the available ldap schema configuration.
@@ -22,6 +28,25 @@ type LDAPSchema struct {
 	DisplayName string
 	Mail        string
 	Groups      string
+	UIDNumber   string
+	GIDNumber   string
+	GroupID     string
+	GroupName   string
+}
+
+// LDAPServer defines the available configuration of the built-in ldap server.
+type LDAPServer struct {
+	Addr     string
+	BaseDN   string
+	CertFile string
+	KeyFile  string
+}
+
+// Debug defines the available debug configuration.
+type Debug struct {
+	Addr  string
+	Token string
+	Pprof bool
 }
 
 // HTTP defines the available http configuration.
@@ -31,16 +56,60 @@ type HTTP struct {
 	Root      string
 }
 
+// SCIM defines the available scim configuration.
+type SCIM struct {
+	Token string
+}
+
 // GRPC defines the available grpc configuration.
 type GRPC struct {
-	Addr      string
-	Namespace string
+	Addr                 string
+	Namespace            string
+	AllowUnauthenticated bool
 }
 
 // Server configures a server.
 type Server struct {
 	Name             string
 	AccountsDataPath string
+	DeletedRetention time.Duration
+	PurgeInterval    time.Duration
+	ScheduleInterval time.Duration
+	AuditLog         string
+}
+
+// Bound defines a lower and upper bound.
+type Bound struct {
+	Lower int64
+	Upper int64
+}
+
+// Posix defines the ranges for automatically allocated uid and gid numbers.
+type Posix struct {
+	UID        Bound
+	GID        Bound
+	DefaultGID int64
+}
+
+// Bootstrap defines the records a new accounts data path is initialized with.
+type Bootstrap struct {
+	SeedFile      string
+	DemoUsers     bool
+	AdminPassword string
+}
+
+// Invitations defines how long the invitations of guests can be redeemed.
+type Invitations struct {
+	ValidFor time.Duration
+}
+
+// Provisioning defines how accounts are created for unknown external identities on their first sign in. The claims
+// map the claims of the identity to the properties of the new account.
+type Provisioning struct {
+	Enabled          bool
+	UsernameClaim    string
+	DisplayNameClaim string
+	MailClaim        string
 }
 
 // Asset defines the available asset configuration.
@@ -63,9 +132,16 @@ type Log struct {
 // Config merges all Account config parameters.
 type Config struct {
 	LDAP         LDAP
+	LDAPServer   LDAPServer
+	Debug        Debug
 	HTTP         HTTP
+	SCIM         SCIM
 	GRPC         GRPC
 	Server       Server
+	Posix        Posix
+	Bootstrap    Bootstrap
+	Provisioning Provisioning
+	Invitations  Invitations
 	Asset        Asset
 	Log          Log
 	TokenManager TokenManager
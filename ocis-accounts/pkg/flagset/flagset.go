@@ -1,6 +1,8 @@
 package flagset
 
 import (
+	"time"
+
 	"github.com/micro/cli/v2"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
@@ -36,6 +38,26 @@ func RootWithConfig(cfg *config.Config) []cli.Flag {
 // ServerWithConfig applies cfg to the root flagset
 func ServerWithConfig(cfg *config.Config) []cli.Flag {
 	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "debug-addr",
+			Value:       "0.0.0.0:9182",
+			Usage:       "Address to bind debug server",
+			EnvVars:     []string{"ACCOUNTS_DEBUG_ADDR"},
+			Destination: &cfg.Debug.Addr,
+		},
+		&cli.StringFlag{
+			Name:        "debug-token",
+			Value:       "",
+			Usage:       "Token to grant access to the debug endpoints",
+			EnvVars:     []string{"ACCOUNTS_DEBUG_TOKEN"},
+			Destination: &cfg.Debug.Token,
+		},
+		&cli.BoolFlag{
+			Name:        "debug-pprof",
+			Usage:       "Enable pprof debugging",
+			EnvVars:     []string{"ACCOUNTS_DEBUG_PPROF"},
+			Destination: &cfg.Debug.Pprof,
+		},
 		&cli.StringFlag{
 			Name:        "http-namespace",
 			Value:       "com.owncloud.web",
@@ -57,6 +79,13 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_HTTP_ROOT"},
 			Destination: &cfg.HTTP.Root,
 		},
+		&cli.StringFlag{
+			Name:        "scim-token",
+			Value:       "",
+			Usage:       "Bearer token for the SCIM endpoint, the endpoint is disabled if empty",
+			EnvVars:     []string{"ACCOUNTS_SCIM_TOKEN"},
+			Destination: &cfg.SCIM.Token,
+		},
 		&cli.StringFlag{
 			Name:        "grpc-namespace",
 			Value:       "com.owncloud.api",
@@ -71,6 +100,13 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_GRPC_ADDR"},
 			Destination: &cfg.GRPC.Addr,
 		},
+		&cli.BoolFlag{
+			Name:        "grpc-allow-unauthenticated",
+			Value:       false,
+			Usage:       "Trust grpc requests without a service token like services, for callers that do not send one yet",
+			EnvVars:     []string{"ACCOUNTS_GRPC_ALLOW_UNAUTHENTICATED"},
+			Destination: &cfg.GRPC.AllowUnauthenticated,
+		},
 		&cli.StringFlag{
 			Name:        "name",
 			Value:       "accounts",
@@ -85,6 +121,293 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_DATA_PATH"},
 			Destination: &cfg.Server.AccountsDataPath,
 		},
+		&cli.DurationFlag{
+			Name:        "deleted-retention",
+			Value:       30 * 24 * time.Hour,
+			Usage:       "How long deleted accounts and groups are kept before they are purged",
+			EnvVars:     []string{"ACCOUNTS_DELETED_RETENTION"},
+			Destination: &cfg.Server.DeletedRetention,
+		},
+		&cli.DurationFlag{
+			Name:        "purge-interval",
+			Value:       time.Hour,
+			Usage:       "Interval for purging deleted accounts and groups after their retention period",
+			EnvVars:     []string{"ACCOUNTS_PURGE_INTERVAL"},
+			Destination: &cfg.Server.PurgeInterval,
+		},
+		&cli.DurationFlag{
+			Name:        "schedule-interval",
+			Value:       time.Minute,
+			Usage:       "Interval for disabling expired accounts and enabling accounts on their scheduled date",
+			EnvVars:     []string{"ACCOUNTS_SCHEDULE_INTERVAL"},
+			Destination: &cfg.Server.ScheduleInterval,
+		},
+		&cli.StringFlag{
+			Name:        "audit-log",
+			Value:       "",
+			Usage:       "Append-only JSONL file recording all account and group mutations, defaults to audit.jsonl in the accounts data path",
+			EnvVars:     []string{"ACCOUNTS_AUDIT_LOG"},
+			Destination: &cfg.Server.AuditLog,
+		},
+		&cli.Int64Flag{
+			Name:        "uid-lower-bound",
+			Value:       20000,
+			Usage:       "Lowest uid number that is allocated for new accounts",
+			EnvVars:     []string{"ACCOUNTS_UID_LOWER_BOUND"},
+			Destination: &cfg.Posix.UID.Lower,
+		},
+		&cli.Int64Flag{
+			Name:        "uid-upper-bound",
+			Value:       29999,
+			Usage:       "Highest uid number that is allocated for new accounts",
+			EnvVars:     []string{"ACCOUNTS_UID_UPPER_BOUND"},
+			Destination: &cfg.Posix.UID.Upper,
+		},
+		&cli.Int64Flag{
+			Name:        "gid-lower-bound",
+			Value:       30000,
+			Usage:       "Lowest gid number that is allocated for new groups",
+			EnvVars:     []string{"ACCOUNTS_GID_LOWER_BOUND"},
+			Destination: &cfg.Posix.GID.Lower,
+		},
+		&cli.Int64Flag{
+			Name:        "gid-upper-bound",
+			Value:       39999,
+			Usage:       "Highest gid number that is allocated for new groups",
+			EnvVars:     []string{"ACCOUNTS_GID_UPPER_BOUND"},
+			Destination: &cfg.Posix.GID.Upper,
+		},
+		&cli.Int64Flag{
+			Name:        "default-gid",
+			Value:       30000,
+			Usage:       "Primary gid number of new accounts that don't provide one",
+			EnvVars:     []string{"ACCOUNTS_DEFAULT_GID"},
+			Destination: &cfg.Posix.DefaultGID,
+		},
+		&cli.StringFlag{
+			Name:        "seed-file",
+			Value:       "",
+			Usage:       "YAML or JSON file with the accounts and groups a new accounts data path is initialized with",
+			EnvVars:     []string{"ACCOUNTS_SEED_FILE"},
+			Destination: &cfg.Bootstrap.SeedFile,
+		},
+		&cli.BoolFlag{
+			Name:        "demo-users",
+			Value:       false,
+			Usage:       "Initialize a new accounts data path with the demo users and groups",
+			EnvVars:     []string{"ACCOUNTS_DEMO_USERS"},
+			Destination: &cfg.Bootstrap.DemoUsers,
+		},
+		&cli.StringFlag{
+			Name:        "admin-password",
+			Value:       "",
+			Usage:       "Password of the initial admin account, a random password is generated and printed once if not set",
+			EnvVars:     []string{"ACCOUNTS_ADMIN_PASSWORD"},
+			Destination: &cfg.Bootstrap.AdminPassword,
+		},
+		&cli.DurationFlag{
+			Name:        "invitation-valid-for",
+			Value:       7 * 24 * time.Hour,
+			Usage:       "How long guests can redeem their invitations",
+			EnvVars:     []string{"ACCOUNTS_INVITATION_VALID_FOR"},
+			Destination: &cfg.Invitations.ValidFor,
+		},
+		&cli.BoolFlag{
+			Name:        "provisioning",
+			Value:       false,
+			Usage:       "Create accounts for unknown external identities on their first sign in",
+			EnvVars:     []string{"ACCOUNTS_PROVISIONING"},
+			Destination: &cfg.Provisioning.Enabled,
+		},
+		&cli.StringFlag{
+			Name:        "provisioning-username-claim",
+			Value:       "preferred_username",
+			Usage:       "Claim that is used as the username of provisioned accounts",
+			EnvVars:     []string{"ACCOUNTS_PROVISIONING_USERNAME_CLAIM"},
+			Destination: &cfg.Provisioning.UsernameClaim,
+		},
+		&cli.StringFlag{
+			Name:        "provisioning-display-name-claim",
+			Value:       "name",
+			Usage:       "Claim that is used as the display name of provisioned accounts",
+			EnvVars:     []string{"ACCOUNTS_PROVISIONING_DISPLAY_NAME_CLAIM"},
+			Destination: &cfg.Provisioning.DisplayNameClaim,
+		},
+		&cli.StringFlag{
+			Name:        "provisioning-mail-claim",
+			Value:       "email",
+			Usage:       "Claim that is used as the mail of provisioned accounts",
+			EnvVars:     []string{"ACCOUNTS_PROVISIONING_MAIL_CLAIM"},
+			Destination: &cfg.Provisioning.MailClaim,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-hostname",
+			Value:       "",
+			Usage:       "Hostname of an LDAP server to read accounts and groups from, the local storage is used if empty",
+			EnvVars:     []string{"ACCOUNTS_LDAP_HOSTNAME"},
+			Destination: &cfg.LDAP.Hostname,
+		},
+		&cli.IntFlag{
+			Name:        "ldap-port",
+			Value:       389,
+			Usage:       "Port of the LDAP server",
+			EnvVars:     []string{"ACCOUNTS_LDAP_PORT"},
+			Destination: &cfg.LDAP.Port,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-base-dn",
+			Value:       "dc=example,dc=org",
+			Usage:       "Base DN for searching accounts and groups",
+			EnvVars:     []string{"ACCOUNTS_LDAP_BASE_DN"},
+			Destination: &cfg.LDAP.BaseDN,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-userfilter",
+			Value:       "(objectclass=posixAccount)",
+			Usage:       "Filter that matches all accounts",
+			EnvVars:     []string{"ACCOUNTS_LDAP_USERFILTER"},
+			Destination: &cfg.LDAP.UserFilter,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-groupfilter",
+			Value:       "(objectclass=posixGroup)",
+			Usage:       "Filter that matches all groups",
+			EnvVars:     []string{"ACCOUNTS_LDAP_GROUPFILTER"},
+			Destination: &cfg.LDAP.GroupFilter,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-bind-dn",
+			Value:       "",
+			Usage:       "DN to bind with for searching, an anonymous bind is used if empty",
+			EnvVars:     []string{"ACCOUNTS_LDAP_BIND_DN"},
+			Destination: &cfg.LDAP.BindDN,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-bind-password",
+			Value:       "",
+			Usage:       "Password for the bind DN",
+			EnvVars:     []string{"ACCOUNTS_LDAP_BIND_PASSWORD"},
+			Destination: &cfg.LDAP.BindPassword,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-tls",
+			Value:       "starttls",
+			Usage:       "Transport security of the connection to the LDAP server: starttls, ldaps or none",
+			EnvVars:     []string{"ACCOUNTS_LDAP_TLS"},
+			Destination: &cfg.LDAP.TLS,
+		},
+		&cli.BoolFlag{
+			Name:        "ldap-insecure",
+			Value:       false,
+			Usage:       "Allow connections to the LDAP server without TLS, passwords are sent in clear text then",
+			EnvVars:     []string{"ACCOUNTS_LDAP_INSECURE"},
+			Destination: &cfg.LDAP.Insecure,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-account-id",
+			Value:       "entryUUID",
+			Usage:       "LDAP attribute used as account id",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_ACCOUNT_ID"},
+			Destination: &cfg.LDAP.Schema.AccountID,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-username",
+			Value:       "uid",
+			Usage:       "LDAP attribute used as login name",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_USERNAME"},
+			Destination: &cfg.LDAP.Schema.Username,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-displayname",
+			Value:       "displayName",
+			Usage:       "LDAP attribute used as display name",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_DISPLAYNAME"},
+			Destination: &cfg.LDAP.Schema.DisplayName,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-mail",
+			Value:       "mail",
+			Usage:       "LDAP attribute used as mail address",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_MAIL"},
+			Destination: &cfg.LDAP.Schema.Mail,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-groups",
+			Value:       "memberOf",
+			Usage:       "LDAP attribute of accounts containing the DNs of their groups",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_GROUPS"},
+			Destination: &cfg.LDAP.Schema.Groups,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-uid-number",
+			Value:       "uidNumber",
+			Usage:       "LDAP attribute used as uid number",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_UID_NUMBER"},
+			Destination: &cfg.LDAP.Schema.UIDNumber,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-gid-number",
+			Value:       "gidNumber",
+			Usage:       "LDAP attribute used as gid number of accounts and groups",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_GID_NUMBER"},
+			Destination: &cfg.LDAP.Schema.GIDNumber,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-group-id",
+			Value:       "entryUUID",
+			Usage:       "LDAP attribute used as group id",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_GROUP_ID"},
+			Destination: &cfg.LDAP.Schema.GroupID,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-schema-group-name",
+			Value:       "cn",
+			Usage:       "LDAP attribute used as group name",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SCHEMA_GROUP_NAME"},
+			Destination: &cfg.LDAP.Schema.GroupName,
+		},
+		&cli.BoolFlag{
+			Name:        "ldap-sync",
+			Value:       false,
+			Usage:       "Import accounts and groups from LDAP into the local storage instead of reading them from LDAP directly",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SYNC"},
+			Destination: &cfg.LDAP.Sync,
+		},
+		&cli.DurationFlag{
+			Name:        "ldap-sync-interval",
+			Value:       time.Hour,
+			Usage:       "Interval for synchronising accounts and groups from LDAP, 0 disables the scheduled synchronisation",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SYNC_INTERVAL"},
+			Destination: &cfg.LDAP.SyncInterval,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-server-addr",
+			Value:       "",
+			Usage:       "Address the built-in LDAP server listens on, an empty address disables it",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SERVER_ADDR"},
+			Destination: &cfg.LDAPServer.Addr,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-server-base-dn",
+			Value:       "dc=example,dc=org",
+			Usage:       "Base DN of the built-in LDAP server, users are placed below ou=users and groups below ou=groups",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SERVER_BASE_DN"},
+			Destination: &cfg.LDAPServer.BaseDN,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-server-cert",
+			Value:       "",
+			Usage:       "Certificate file of the built-in LDAP server, connections use TLS when it is set",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SERVER_CERT"},
+			Destination: &cfg.LDAPServer.CertFile,
+		},
+		&cli.StringFlag{
+			Name:        "ldap-server-key",
+			Value:       "",
+			Usage:       "Key file of the built-in LDAP server",
+			EnvVars:     []string{"ACCOUNTS_LDAP_SERVER_KEY"},
+			Destination: &cfg.LDAPServer.KeyFile,
+		},
 		&cli.StringFlag{
 			Name:        "asset-path",
 			Value:       "",
@@ -95,7 +418,7 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 		&cli.StringFlag{
 			Name:        "jwt-secret",
 			Value:       "Pive-Fumkiu4",
-			Usage:       "Used to create JWT to talk to reva, should equal reva's jwt-secret",
+			Usage:       "Used to verify access tokens and the service tokens of grpc requests, should equal reva's jwt-secret",
 			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
 			Destination: &cfg.TokenManager.JWTSecret,
 		},
@@ -123,6 +446,13 @@ func UpdateAccountWithConfig(cfg *config.Config, a *accounts.Account) []cli.Flag
 			EnvVars:     []string{"ACCOUNTS_NAME"},
 			Destination: &cfg.Server.Name,
 		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
 		&cli.BoolFlag{
 			Name:        "enabled",
 			Usage:       "Enable the account",
@@ -163,6 +493,11 @@ func UpdateAccountWithConfig(cfg *config.Config, a *accounts.Account) []cli.Flag
 			Usage:       "Set the description for the account",
 			Destination: &a.Description,
 		},
+		&cli.StringFlag{
+			Name:        "preferred-language",
+			Usage:       "Set the preferred language for the account",
+			Destination: &a.PreferredLanguage,
+		},
 		&cli.StringFlag{
 			Name:        "password",
 			Usage:       "Set the password for the account",
@@ -183,6 +518,14 @@ func UpdateAccountWithConfig(cfg *config.Config, a *accounts.Account) []cli.Flag
 			Usage:       "Force password change on next sign-in with mfa",
 			Destination: &a.PasswordProfile.ForceChangePasswordNextSignInWithMfa,
 		},
+		&cli.StringFlag{
+			Name:  "expiration",
+			Usage: "Disable the account at the given time, e.g. 2021-01-01T00:00:00Z, an empty value removes the expiration",
+		},
+		&cli.StringFlag{
+			Name:  "scheduled-enable",
+			Usage: "Enable the account at the given time, e.g. 2021-01-01T00:00:00Z, an empty value removes the schedule",
+		},
 	}
 }
 
@@ -207,6 +550,13 @@ func AddAccountWithConfig(cfg *config.Config, a *accounts.Account) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_NAME"},
 			Destination: &cfg.Server.Name,
 		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
 		&cli.BoolFlag{
 			Name:        "enabled",
 			Usage:       "Enable the account",
@@ -251,6 +601,11 @@ func AddAccountWithConfig(cfg *config.Config, a *accounts.Account) []cli.Flag {
 			Usage:       "Set the description for the account",
 			Destination: &a.Description,
 		},
+		&cli.StringFlag{
+			Name:        "preferred-language",
+			Usage:       "Set the preferred language for the account",
+			Destination: &a.PreferredLanguage,
+		},
 		&cli.StringFlag{
 			Name:        "password",
 			Usage:       "Set the password for the account",
@@ -271,11 +626,24 @@ func AddAccountWithConfig(cfg *config.Config, a *accounts.Account) []cli.Flag {
 			Usage:       "Force password change on next sign-in with mfa",
 			Destination: &a.PasswordProfile.ForceChangePasswordNextSignInWithMfa,
 		},
+		&cli.StringFlag{
+			Name:  "expiration",
+			Usage: "Disable the account at the given time, e.g. 2021-01-01T00:00:00Z, an empty value removes the expiration",
+		},
+		&cli.StringFlag{
+			Name:  "scheduled-enable",
+			Usage: "Enable the account at the given time, e.g. 2021-01-01T00:00:00Z, an empty value removes the schedule",
+		},
+		&cli.BoolFlag{
+			Name:        "service-account",
+			Usage:       "Create a service account, which authenticates with api keys",
+			Destination: &a.IsServiceAccount,
+		},
 	}
 }
 
 // ListAccountsWithConfig applies list command flags to cfg
-func ListAccountsWithConfig(cfg *config.Config) []cli.Flag {
+func ListAccountsWithConfig(cfg *config.Config, req *accounts.ListAccountsRequest) []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
 			Name:        "grpc-namespace",
@@ -291,6 +659,32 @@ func ListAccountsWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_NAME"},
 			Destination: &cfg.Server.Name,
 		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+		&cli.BoolFlag{
+			Name:        "service-accounts",
+			Usage:       "Also list service accounts",
+			Destination: &req.ShowServiceAccounts,
+		},
+		&cli.BoolFlag{
+			Name:        "guests",
+			Usage:       "Also list guests",
+			Destination: &req.ShowGuests,
+		},
+		&cli.StringFlag{
+			Name:        "query",
+			Usage:       "Only list accounts matching the odata filter, e.g. \"expiration_date_time lt 2021-01-01T00:00:00Z\"",
+			Destination: &req.Query,
+		},
+		&cli.DurationFlag{
+			Name:  "expiring-within",
+			Usage: "Only list accounts that expire within the duration, including the expired ones",
+		},
 	}
 }
 
@@ -311,6 +705,112 @@ func RemoveAccountWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_NAME"},
 			Destination: &cfg.Server.Name,
 		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+	}
+}
+
+// RestoreAccountWithConfig applies restore command flags to cfg
+func RestoreAccountWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "grpc-namespace",
+			Value:       "com.owncloud.api",
+			Usage:       "Set the base namespace for the grpc namespace",
+			EnvVars:     []string{"ACCOUNTS_GRPC_NAMESPACE"},
+			Destination: &cfg.GRPC.Namespace,
+		},
+		&cli.StringFlag{
+			Name:        "name",
+			Value:       "accounts",
+			Usage:       "service name",
+			EnvVars:     []string{"ACCOUNTS_NAME"},
+			Destination: &cfg.Server.Name,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+	}
+}
+
+// RevokeSessionsWithConfig applies revoke-sessions command flags to cfg
+func RevokeSessionsWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "grpc-namespace",
+			Value:       "com.owncloud.api",
+			Usage:       "Set the base namespace for the grpc namespace",
+			EnvVars:     []string{"ACCOUNTS_GRPC_NAMESPACE"},
+			Destination: &cfg.GRPC.Namespace,
+		},
+		&cli.StringFlag{
+			Name:        "name",
+			Value:       "accounts",
+			Usage:       "service name",
+			EnvVars:     []string{"ACCOUNTS_NAME"},
+			Destination: &cfg.Server.Name,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+	}
+}
+
+// CheckWithConfig applies check command flags to cfg
+func CheckWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "accounts-data-path",
+			Value:       "/var/tmp/ocis-accounts",
+			Usage:       "accounts folder",
+			EnvVars:     []string{"ACCOUNTS_DATA_PATH"},
+			Destination: &cfg.Server.AccountsDataPath,
+		},
+	}
+}
+
+// SyncWithConfig applies sync command flags to cfg
+func SyncWithConfig(cfg *config.Config, req *accounts.SyncAccountsRequest) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "grpc-namespace",
+			Value:       "com.owncloud.api",
+			Usage:       "Set the base namespace for the grpc namespace",
+			EnvVars:     []string{"ACCOUNTS_GRPC_NAMESPACE"},
+			Destination: &cfg.GRPC.Namespace,
+		},
+		&cli.StringFlag{
+			Name:        "name",
+			Value:       "accounts",
+			Usage:       "service name",
+			EnvVars:     []string{"ACCOUNTS_NAME"},
+			Destination: &cfg.Server.Name,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+		&cli.BoolFlag{
+			Name:        "dry-run",
+			Usage:       "Only show the changes without applying them",
+			Destination: &req.DryRun,
+		},
 	}
 }
 
@@ -331,5 +831,148 @@ func InspectAccountWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_NAME"},
 			Destination: &cfg.Server.Name,
 		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+	}
+}
+
+// APIKeysWithConfig applies api-keys command flags to cfg
+func APIKeysWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "grpc-namespace",
+			Value:       "com.owncloud.api",
+			Usage:       "Set the base namespace for the grpc namespace",
+			EnvVars:     []string{"ACCOUNTS_GRPC_NAMESPACE"},
+			Destination: &cfg.GRPC.Namespace,
+		},
+		&cli.StringFlag{
+			Name:        "name",
+			Value:       "accounts",
+			Usage:       "service name",
+			EnvVars:     []string{"ACCOUNTS_NAME"},
+			Destination: &cfg.Server.Name,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+	}
+}
+
+// CreateAPIKeyWithConfig applies api-keys create command flags to cfg
+func CreateAPIKeyWithConfig(cfg *config.Config, req *accounts.CreateApiKeyRequest, validFor *time.Duration) []cli.Flag {
+	return append(APIKeysWithConfig(cfg),
+		&cli.StringFlag{
+			Name:        "key-name",
+			Usage:       "A name describing where the key is used",
+			Required:    true,
+			Destination: &req.Name,
+		},
+		&cli.DurationFlag{
+			Name:        "valid-for",
+			Usage:       "Let the key expire after the given duration, e.g. 2160h, keys without expiration are valid until revoked",
+			Destination: validFor,
+		},
+	)
+}
+
+// RotateAPIKeyWithConfig applies api-keys rotate command flags to cfg
+func RotateAPIKeyWithConfig(cfg *config.Config, validFor *time.Duration) []cli.Flag {
+	return append(APIKeysWithConfig(cfg),
+		&cli.DurationFlag{
+			Name:        "valid-for",
+			Usage:       "Let the new secret expire after the given duration, e.g. 2160h, keys without expiration are valid until revoked",
+			Destination: validFor,
+		},
+	)
+}
+
+// IdentitiesWithConfig applies identities command flags to cfg
+func IdentitiesWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "grpc-namespace",
+			Value:       "com.owncloud.api",
+			Usage:       "Set the base namespace for the grpc namespace",
+			EnvVars:     []string{"ACCOUNTS_GRPC_NAMESPACE"},
+			Destination: &cfg.GRPC.Namespace,
+		},
+		&cli.StringFlag{
+			Name:        "name",
+			Value:       "accounts",
+			Usage:       "service name",
+			EnvVars:     []string{"ACCOUNTS_NAME"},
+			Destination: &cfg.Server.Name,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+	}
+}
+
+// LinkIdentityWithConfig applies identities link command flags to cfg
+func LinkIdentityWithConfig(cfg *config.Config, identity *accounts.Identities) []cli.Flag {
+	return append(IdentitiesWithConfig(cfg),
+		&cli.StringFlag{
+			Name:        "sign-in-type",
+			Value:       "federated",
+			Usage:       "The sign in type of the identity, e.g. federated, emailAddress or userName",
+			Destination: &identity.SignInType,
+		},
+	)
+}
+
+// InviteGuestWithConfig applies invite command flags to cfg
+func InviteGuestWithConfig(cfg *config.Config, req *accounts.InviteGuestRequest, validFor *time.Duration) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "grpc-namespace",
+			Value:       "com.owncloud.api",
+			Usage:       "Set the base namespace for the grpc namespace",
+			EnvVars:     []string{"ACCOUNTS_GRPC_NAMESPACE"},
+			Destination: &cfg.GRPC.Namespace,
+		},
+		&cli.StringFlag{
+			Name:        "name",
+			Value:       "accounts",
+			Usage:       "service name",
+			EnvVars:     []string{"ACCOUNTS_NAME"},
+			Destination: &cfg.Server.Name,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Value:       "Pive-Fumkiu4",
+			Usage:       "Used to sign the service tokens of the requests, should equal the accounts service's jwt-secret",
+			EnvVars:     []string{"ACCOUNTS_JWT_SECRET"},
+			Destination: &cfg.TokenManager.JWTSecret,
+		},
+		&cli.StringFlag{
+			Name:        "display-name",
+			Usage:       "Set the display name of the guest, defaults to the mail address",
+			Destination: &req.DisplayName,
+		},
+		&cli.StringFlag{
+			Name:        "preferred-name",
+			Usage:       "Set the username of the guest, defaults to the mail address",
+			Destination: &req.PreferredName,
+		},
+		&cli.DurationFlag{
+			Name:        "valid-for",
+			Usage:       "Let the invitation expire after the given duration, e.g. 72h, defaults to the validity configured for the service",
+			Destination: validFor,
+		},
 	}
 }
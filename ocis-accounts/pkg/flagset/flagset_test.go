@@ -0,0 +1,20 @@
+package flagset
+
+import (
+	"testing"
+
+	"github.com/micro/cli/v2"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerDeniesUnauthenticatedByDefault(t *testing.T) {
+	cfg := config.New()
+	app := &cli.App{
+		Flags:  ServerWithConfig(cfg),
+		Action: func(c *cli.Context) error { return nil },
+	}
+	require.NoError(t, app.Run([]string{"accounts"}))
+	assert.False(t, cfg.GRPC.AllowUnauthenticated)
+}
@@ -0,0 +1,703 @@
+// Package graph implements a subset of the MS Graph users and groups api on top of the accounts and groups services.
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/go-chi/chi"
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxBodySize limits the size of request bodies
+const maxBodySize = 1 << 20
+
+// graphError is an error with the http status and graph error code
+type graphError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *graphError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &graphError{status: http.StatusBadRequest, code: "invalidRequest", message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &graphError{status: http.StatusNotFound, code: "itemNotFound", message: fmt.Sprintf(format, args...)}
+}
+
+// errorCodes maps http status codes to the generic graph error codes
+var errorCodes = map[int]string{
+	http.StatusBadRequest:         "invalidRequest",
+	http.StatusUnauthorized:       "unauthenticated",
+	http.StatusForbidden:          "accessDenied",
+	http.StatusNotFound:           "itemNotFound",
+	http.StatusMethodNotAllowed:   "notAllowed",
+	http.StatusConflict:           "nameAlreadyExists",
+	http.StatusPreconditionFailed: "resourceModified",
+}
+
+// fromServiceError converts the errors of the accounts service
+func fromServiceError(err error) *graphError {
+	if e, ok := err.(*graphError); ok {
+		return e
+	}
+	e := merrors.Parse(err.Error())
+	status := int(e.Code)
+	if status == 0 {
+		status = http.StatusInternalServerError
+	}
+	code, ok := errorCodes[status]
+	if !ok {
+		code = "generalException"
+	}
+	return &graphError{status: status, code: code, message: e.Detail}
+}
+
+type handler struct {
+	Options
+}
+
+// NewHandler returns the http handler for the graph endpoint. It has to be mounted on the configured root.
+// Requests are authorized by the accounts and groups services, like the requests to the rpc api.
+func NewHandler(opts ...Option) http.Handler {
+	h := handler{Options: newOptions(opts...)}
+
+	r := chi.NewRouter()
+	r.Route("/users", func(r chi.Router) {
+		r.Get("/", h.listUsers)
+		r.Post("/", h.createUser)
+		r.Get("/{id}", h.getUser)
+		r.Patch("/{id}", h.updateUser)
+		r.Delete("/{id}", h.deleteUser)
+		r.Get("/{id}/memberOf", h.listMemberOf)
+		r.Post("/{id}/revokeSignInSessions", h.revokeSignInSessions)
+	})
+	r.Route("/groups", func(r chi.Router) {
+		r.Get("/", h.listGroups)
+		r.Post("/", h.createGroup)
+		r.Get("/{id}", h.getGroup)
+		r.Patch("/{id}", h.updateGroup)
+		r.Delete("/{id}", h.deleteGroup)
+		r.Get("/{id}/members", h.listMembers)
+		r.Post("/{id}/members/$ref", h.addMember)
+		r.Delete("/{id}/members/{memberID}/$ref", h.removeMember)
+		r.Get("/{id}/owners", h.listOwners)
+		r.Post("/{id}/owners/$ref", h.addOwner)
+		r.Delete("/{id}/owners/{ownerID}/$ref", h.removeOwner)
+	})
+	return r
+}
+
+func (h handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	query, err := filterQuery(r, users)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := &proto.ListAccountsResponse{}
+	if err := h.AccountsService.ListAccounts(r.Context(), &proto.ListAccountsRequest{Query: query, ShowGuests: true}, res); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	messages := make([]gproto.Message, 0, len(res.Accounts))
+	for _, a := range res.Accounts {
+		messages = append(messages, a)
+	}
+	h.writeCollection(w, r, users, "", messages)
+}
+
+func (h handler) getUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.writeEntity(w, r, http.StatusOK, users, a)
+}
+
+func (h handler) createUser(w http.ResponseWriter, r *http.Request) {
+	a := &proto.Account{}
+	if _, err := decode(r, a); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	// ids are always assigned by the service and memberships are managed on the groups
+	a.Id = ""
+	a.MemberOf = nil
+	if a.PreferredName == "" {
+		a.PreferredName = a.OnPremisesSamAccountName
+	}
+	if a.OnPremisesSamAccountName == "" {
+		a.OnPremisesSamAccountName = a.PreferredName
+	}
+
+	out := &proto.Account{}
+	if err := h.AccountsService.CreateAccount(r.Context(), &proto.CreateAccountRequest{Account: a}, out); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.Header().Set("Location", h.base(r)+"/users/"+out.Id)
+	h.writeEntity(w, r, http.StatusCreated, users, out)
+}
+
+func (h handler) updateUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	update := &proto.Account{}
+	body, err := decode(r, update)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	paths, err := maskPaths(body, update.ProtoReflect().Descriptor())
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if len(paths) == 0 {
+		// an empty mask would update all properties
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	update.Id = a.Id
+	if err := h.AccountsService.UpdateAccount(r.Context(), &proto.UpdateAccountRequest{
+		Account:    update,
+		UpdateMask: &field_mask.FieldMask{Paths: paths},
+	}, &proto.Account{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.AccountsService.DeleteAccount(r.Context(), &proto.DeleteAccountRequest{Id: a.Id}, &empty.Empty{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) listMemberOf(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	messages := make([]gproto.Message, 0, len(a.MemberOf))
+	for _, g := range a.MemberOf {
+		messages = append(messages, g)
+	}
+	h.writeCollection(w, r, groups, "#microsoft.graph.group", messages)
+}
+
+func (h handler) revokeSignInSessions(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.AccountsService.RevokeSessions(r.Context(), &proto.RevokeSessionsRequest{Id: a.Id}, &proto.Account{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.write(w, http.StatusOK, map[string]interface{}{
+		"@odata.context": h.context(r, "Edm.Boolean"),
+		"value":          true,
+	})
+}
+
+func (h handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	query, err := filterQuery(r, groups)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := &proto.ListGroupsResponse{}
+	if err := h.GroupsService.ListGroups(r.Context(), &proto.ListGroupsRequest{Query: query}, res); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	messages := make([]gproto.Message, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		if g.DeletedDateTime == nil {
+			messages = append(messages, g)
+		}
+	}
+	h.writeCollection(w, r, groups, "", messages)
+}
+
+func (h handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.writeEntity(w, r, http.StatusOK, groups, g)
+}
+
+func (h handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	g := &proto.Group{}
+	if _, err := decode(r, g); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	// ids are always assigned by the service, members are added with the members/$ref endpoint so the memberships
+	// of the accounts are maintained, owners with the owners/$ref endpoint
+	g.Id = ""
+	g.Members = nil
+	g.Owners = nil
+	if g.DisplayName == "" {
+		h.writeError(w, badRequest("displayName is required"))
+		return
+	}
+	if g.OnPremisesSamAccountName == "" {
+		g.OnPremisesSamAccountName = g.DisplayName
+	}
+
+	out := &proto.Group{}
+	if err := h.GroupsService.CreateGroup(r.Context(), &proto.CreateGroupRequest{Group: g}, out); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.Header().Set("Location", h.base(r)+"/groups/"+out.Id)
+	h.writeEntity(w, r, http.StatusCreated, groups, out)
+}
+
+func (h handler) updateGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	update := &proto.Group{}
+	body, err := decode(r, update)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	paths, err := maskPaths(body, update.ProtoReflect().Descriptor())
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if len(paths) == 0 {
+		// an empty mask would update all properties
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	update.Id = g.Id
+	if err := h.GroupsService.UpdateGroup(r.Context(), &proto.UpdateGroupRequest{
+		Group:      update,
+		UpdateMask: &field_mask.FieldMask{Paths: paths},
+	}, &proto.Group{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.GroupsService.DeleteGroup(r.Context(), &proto.DeleteGroupRequest{Id: g.Id}, &empty.Empty{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) listMembers(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := &proto.ListMembersResponse{}
+	if err := h.GroupsService.ListMembers(r.Context(), &proto.ListMembersRequest{Id: g.Id}, res); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	messages := make([]gproto.Message, 0, len(res.Members))
+	for _, a := range res.Members {
+		messages = append(messages, a)
+	}
+	h.writeCollection(w, r, users, "#microsoft.graph.user", messages)
+}
+
+// addMember adds the account referenced by the @odata.id of the request body to the group
+func (h handler) addMember(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	ref := struct {
+		ID string `json:"@odata.id"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxBodySize)).Decode(&ref); err != nil {
+		h.writeError(w, badRequest("could not parse request body: %v", err))
+		return
+	}
+	id := referencedID(ref.ID)
+	if id == "" {
+		h.writeError(w, badRequest("@odata.id is required"))
+		return
+	}
+	if _, err := h.loadAccount(r.Context(), id); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	for _, m := range g.Members {
+		if m.Id == id {
+			h.writeError(w, &graphError{status: http.StatusBadRequest, code: "invalidRequest", message: "the account is already a member of the group"})
+			return
+		}
+	}
+	if err := h.GroupsService.AddMember(r.Context(), &proto.AddMemberRequest{GroupId: g.Id, AccountId: id}, &proto.Group{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) removeMember(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	id := chi.URLParam(r, "memberID")
+	member := false
+	for _, m := range g.Members {
+		if m.Id == id {
+			member = true
+			break
+		}
+	}
+	if !member {
+		h.writeError(w, notFound("account %s is not a member of group %s", id, g.Id))
+		return
+	}
+	if err := h.GroupsService.RemoveMember(r.Context(), &proto.RemoveMemberRequest{GroupId: g.Id, AccountId: id}, &proto.Group{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) listOwners(w http.ResponseWriter, r *http.Request) {
+	res := &proto.ListOwnersResponse{}
+	if err := h.GroupsService.ListOwners(r.Context(), &proto.ListOwnersRequest{Id: chi.URLParam(r, "id")}, res); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	messages := make([]gproto.Message, 0, len(res.Owners))
+	for _, a := range res.Owners {
+		messages = append(messages, a)
+	}
+	h.writeCollection(w, r, users, "#microsoft.graph.user", messages)
+}
+
+// addOwner adds the account referenced by the @odata.id of the request body to the owners of the group
+func (h handler) addOwner(w http.ResponseWriter, r *http.Request) {
+	ref := struct {
+		ID string `json:"@odata.id"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxBodySize)).Decode(&ref); err != nil {
+		h.writeError(w, badRequest("could not parse request body: %v", err))
+		return
+	}
+	id := referencedID(ref.ID)
+	if id == "" {
+		h.writeError(w, badRequest("@odata.id is required"))
+		return
+	}
+	if err := h.GroupsService.AddOwner(r.Context(), &proto.AddOwnerRequest{GroupId: chi.URLParam(r, "id"), AccountId: id}, &proto.Group{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) removeOwner(w http.ResponseWriter, r *http.Request) {
+	req := &proto.RemoveOwnerRequest{GroupId: chi.URLParam(r, "id"), AccountId: chi.URLParam(r, "ownerID")}
+	if err := h.GroupsService.RemoveOwner(r.Context(), req, &proto.Group{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// referencedID extracts the id from references like https://graph.microsoft.com/v1.0/directoryObjects/{id} or
+// directoryObjects('{id}')
+func referencedID(ref string) string {
+	ref = strings.TrimSuffix(strings.TrimSpace(ref), "/")
+	if i := strings.LastIndexByte(ref, '/'); i >= 0 {
+		ref = ref[i+1:]
+	}
+	if i := strings.IndexByte(ref, '('); i >= 0 && strings.HasSuffix(ref, ")") {
+		ref = strings.Trim(ref[i+1:len(ref)-1], "'")
+	}
+	return ref
+}
+
+// loadAccount returns the account, deleted accounts are not found
+func (h handler) loadAccount(ctx context.Context, id string) (*proto.Account, error) {
+	a := &proto.Account{}
+	if err := h.AccountsService.GetAccount(ctx, &proto.GetAccountRequest{Id: id}, a); err != nil {
+		return nil, err
+	}
+	if a.DeletedDateTime != nil {
+		return nil, notFound("user %s not found", id)
+	}
+	return a, nil
+}
+
+// loadGroup returns the group, deleted groups are not found
+func (h handler) loadGroup(ctx context.Context, id string) (*proto.Group, error) {
+	g := &proto.Group{}
+	if err := h.GroupsService.GetGroup(ctx, &proto.GetGroupRequest{Id: id}, g); err != nil {
+		return nil, err
+	}
+	if g.DeletedDateTime != nil {
+		return nil, notFound("group %s not found", id)
+	}
+	return g, nil
+}
+
+// filterQuery translates the $filter parameter to a query of the index
+func filterQuery(r *http.Request, set entitySet) (string, error) {
+	filter := r.URL.Query().Get("$filter")
+	if filter == "" {
+		return "", nil
+	}
+	query, err := translateFilter(filter, set.filter)
+	if err != nil {
+		return "", badRequest("invalid $filter: %v", err)
+	}
+	// the services report syntax errors as internal errors, so they are checked upfront
+	if _, err := godata.ParseFilterString(query); err != nil {
+		return "", badRequest("invalid $filter: %v", err)
+	}
+	return query, nil
+}
+
+// decode reads a json request body into the message and returns the properties it contains. Properties that are not
+// part of the message are ignored.
+func decode(r *http.Request, m gproto.Message) (map[string]json.RawMessage, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, badRequest("could not read request body: %v", err)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, m); err != nil {
+		return nil, badRequest("could not parse request body: %v", err)
+	}
+	body := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, badRequest("could not parse request body: %v", err)
+	}
+	return body, nil
+}
+
+// maskPaths converts the json property names of a PATCH body to field mask paths, the properties of nested messages
+// like the passwordProfile are converted to paths like PasswordProfile.Password. The services reject updates of read
+// only properties.
+func maskPaths(body map[string]json.RawMessage, md protoreflect.MessageDescriptor) ([]string, error) {
+	paths := []string{}
+	for name, value := range body {
+		if strings.Contains(name, "@odata.") {
+			continue
+		}
+		fd := md.Fields().ByJSONName(name)
+		if fd == nil {
+			return nil, badRequest("could not find a property named '%s'", name)
+		}
+		path := goName(fd.Name())
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && fd.Message().ParentFile() == md.ParentFile() {
+			nested := map[string]json.RawMessage{}
+			if err := json.Unmarshal(value, &nested); err != nil {
+				return nil, badRequest("%s must be an object", name)
+			}
+			sub, err := maskPaths(nested, fd.Message())
+			if err != nil {
+				return nil, err
+			}
+			for _, s := range sub {
+				paths = append(paths, path+"."+s)
+			}
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// goName returns the name of the go struct field for a proto field name like on_premises_sam_account_name
+func goName(name protoreflect.Name) string {
+	parts := strings.Split(string(name), "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// base returns the url of the graph endpoint, it is used to build the context and next links
+func (h handler) base(r *http.Request) string {
+	return origin(r) + strings.TrimSuffix(h.Root, "/")
+}
+
+func origin(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + r.Host
+}
+
+// writeEntity writes a single user or group with the properties selected by $select
+func (h handler) writeEntity(w http.ResponseWriter, r *http.Request, status int, set entitySet, m gproto.Message) {
+	selected, err := parseSelect(r, m)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	entity, err := toEntity(m, selected, "")
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	entity["@odata.context"] = h.context(r, set.name+"/$entity")
+	h.write(w, status, entity)
+}
+
+// writeCollection writes the page of messages selected by $orderby, $top and $skiptoken. Members of a collection
+// that is not an entity set are annotated with their odata type.
+func (h handler) writeCollection(w http.ResponseWriter, r *http.Request, set entitySet, odataType string, messages []gproto.Message) {
+	offset, size, err := page(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if orderBy := r.URL.Query().Get("$orderby"); orderBy != "" {
+		clauses, err := parseOrderBy(orderBy, set)
+		if err != nil {
+			h.writeError(w, badRequest("%v", err))
+			return
+		}
+		sortMessages(messages, clauses, set)
+	}
+
+	values := []map[string]json.RawMessage{}
+	for i := offset; i < len(messages) && i < offset+size; i++ {
+		selected, err := parseSelect(r, messages[i])
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		entity, err := toEntity(messages[i], selected, odataType)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		values = append(values, entity)
+	}
+
+	res := map[string]interface{}{
+		"@odata.context": h.context(r, set.name),
+		"value":          values,
+	}
+	if offset+size < len(messages) {
+		q := r.URL.Query()
+		q.Set("$skiptoken", encodeSkipToken(offset+size))
+		res["@odata.nextLink"] = origin(r) + r.URL.Path + "?" + q.Encode()
+	}
+	h.write(w, http.StatusOK, res)
+}
+
+func (h handler) context(r *http.Request, fragment string) json.RawMessage {
+	data, _ := json.Marshal(h.base(r) + "/$metadata#" + fragment)
+	return data
+}
+
+// toEntity converts the message to its json properties, unset properties are null like in the graph api. Passwords
+// are never returned, so the password profile is omitted.
+func toEntity(m gproto.Message, selected map[string]struct{}, odataType string) (map[string]json.RawMessage, error) {
+	data, err := (protojson.MarshalOptions{EmitUnpopulated: true}).Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	entity := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &entity); err != nil {
+		return nil, err
+	}
+	delete(entity, "passwordProfile")
+	if selected != nil {
+		for name := range entity {
+			if _, ok := selected[name]; !ok {
+				delete(entity, name)
+			}
+		}
+	}
+	if odataType != "" {
+		entity["@odata.type"], _ = json.Marshal(odataType)
+	}
+	return entity, nil
+}
+
+func (h handler) writeError(w http.ResponseWriter, err error) {
+	e := fromServiceError(err)
+	if e.status >= http.StatusInternalServerError {
+		h.Logger.Error().Err(err).Msg("graph request failed")
+	}
+	h.write(w, e.status, map[string]interface{}{
+		"error": map[string]string{
+			"code":    e.code,
+			"message": e.message,
+		},
+	})
+}
+
+func (h handler) write(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.Logger.Error().Err(err).Msg("could not write graph response")
+	}
+}
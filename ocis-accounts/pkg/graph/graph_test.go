@@ -0,0 +1,266 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/micro/go-micro/v2/client"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dataPath = "/var/tmp/ocis-accounts-graph-tests"
+
+func TestTranslateFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		query  string
+	}{
+		{"displayName eq 'Jane'", "display_name eq 'Jane'"},
+		{"startswith(onPremisesSamAccountName,'j') and accountEnabled eq true", "startswith(on_premises_sam_account_name,'j') and account_enabled eq true"},
+		{"mail eq 'o''neil@example.org' or not (uidNumber eq 20000)", "mail eq 'o''neil@example.org' or not (uid_number eq 20000)"},
+		{"createdDateTime gt 2020-01-01T00:00:00Z", "created_date_time gt 2020-01-01T00:00:00Z"},
+		{"displayName eq 'id eq mail'", "display_name eq 'id eq mail'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			q, err := translateFilter(tt.filter, users.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.query, q)
+		})
+	}
+
+	for _, filter := range []string{
+		"password eq 'secret'",
+		"login eq 'jdoe'",
+		"memberOf/any(g:g/id eq 'x')",
+		"displayName eq 'unterminated",
+		"displayName eq \"jdoe\"",
+	} {
+		_, err := translateFilter(filter, users.filter)
+		assert.Error(t, err, filter)
+	}
+}
+
+func TestMaskPaths(t *testing.T) {
+	body := map[string]json.RawMessage{
+		"displayName":              json.RawMessage(`"Jane"`),
+		"onPremisesSamAccountName": json.RawMessage(`"jdoe"`),
+		"passwordProfile":          json.RawMessage(`{"password":"secret","forceChangePasswordNextSignIn":true}`),
+		"@odata.type":              json.RawMessage(`"#microsoft.graph.user"`),
+	}
+	paths, err := maskPaths(body, (&proto.Account{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DisplayName", "OnPremisesSamAccountName", "PasswordProfile.ForceChangePasswordNextSignIn", "PasswordProfile.Password"}, paths)
+
+	_, err = maskPaths(map[string]json.RawMessage{"mailNickname": json.RawMessage(`"jdoe"`)}, (&proto.Account{}).ProtoReflect().Descriptor())
+	assert.Error(t, err)
+}
+
+func TestReferencedID(t *testing.T) {
+	assert.Equal(t, "4c510ada", referencedID("https://graph.microsoft.com/v1.0/directoryObjects/4c510ada"))
+	assert.Equal(t, "4c510ada", referencedID("https://localhost/v1.0/directoryObjects('4c510ada')"))
+	assert.Equal(t, "4c510ada", referencedID("4c510ada"))
+}
+
+func newTestHandler(t *testing.T) http.Handler {
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = dataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+
+	roleService := settings.MockRoleService{
+		AssignRoleToUserFunc: func(ctx context.Context, req *settings.AssignRoleToUserRequest, opts ...client.CallOption) (*settings.AssignRoleToUserResponse, error) {
+			return &settings.AssignRoleToUserResponse{Assignment: &settings.UserRoleAssignment{}}, nil
+		},
+	}
+	s, err := svc.New(svc.Logger(olog.NewLogger()), svc.Config(cfg), svc.RoleService(roleService))
+	require.NoError(t, err)
+
+	return NewHandler(
+		Logger(olog.NewLogger()),
+		AccountsService(s),
+		GroupsService(s),
+	)
+}
+
+// do sends an authenticated request and decodes the response into v
+func do(t *testing.T, h http.Handler, method, target, body string, v interface{}) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r = r.WithContext(auth.NewContext(r.Context(), auth.Principal{Service: "com.owncloud.api.test"}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if v != nil && w.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), v), w.Body.String())
+	}
+	return w
+}
+
+type collection struct {
+	Context  string                   `json:"@odata.context"`
+	NextLink string                   `json:"@odata.nextLink"`
+	Value    []map[string]interface{} `json:"value"`
+}
+
+type graphErrorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func TestUsersAndGroups(t *testing.T) {
+	defer os.RemoveAll(dataPath)
+	h := newTestHandler(t)
+
+	ids := map[string]string{}
+	for _, name := range []string{"carol", "alice", "bob"} {
+		u := map[string]interface{}{}
+		w := do(t, h, http.MethodPost, "/users", `{
+			"accountEnabled": true,
+			"displayName": "`+strings.Title(name)+`",
+			"onPremisesSamAccountName": "`+name+`",
+			"mailNickname": "`+name+`",
+			"mail": "`+name+`@example.org",
+			"passwordProfile": {"password": "Secret123!"}
+		}`, &u)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.Equal(t, name, u["preferredName"])
+		assert.NotContains(t, u, "passwordProfile")
+		assert.Equal(t, "http://example.com/v1.0/users/"+u["id"].(string), w.Header().Get("Location"))
+		ids[name] = u["id"].(string)
+	}
+
+	e := &graphErrorResponse{}
+	w := do(t, h, http.MethodPost, "/users", `{"onPremisesSamAccountName": "Alice", "mail": "other@example.org"}`, e)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "nameAlreadyExists", e.Error.Code)
+
+	list := &collection{}
+	w = do(t, h, http.MethodGet, "/users?$orderby=displayName%20desc&$select=id,displayName&$top=2", "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "http://example.com/v1.0/$metadata#users", list.Context)
+	require.Len(t, list.Value, 2)
+	assert.Equal(t, map[string]interface{}{"id": ids["carol"], "displayName": "Carol"}, list.Value[0])
+	assert.Equal(t, "Bob", list.Value[1]["displayName"])
+	require.NotEmpty(t, list.NextLink)
+
+	next, err := url.Parse(list.NextLink)
+	require.NoError(t, err)
+	list = &collection{}
+	w = do(t, h, http.MethodGet, next.RequestURI(), "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, list.Value, 1)
+	assert.Equal(t, "Alice", list.Value[0]["displayName"])
+	assert.Empty(t, list.NextLink)
+
+	list = &collection{}
+	w = do(t, h, http.MethodGet, "/users?$filter="+url.QueryEscape("onPremisesSamAccountName eq 'bob'"), "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, list.Value, 1)
+	assert.Equal(t, ids["bob"], list.Value[0]["id"])
+
+	for _, query := range []string{
+		"$filter=" + url.QueryEscape("password eq 'Secret123!'"),
+		"$filter=" + url.QueryEscape("displayName eq"),
+		"$select=password",
+		"$orderby=password",
+		"$top=1000",
+		"$skiptoken=invalid",
+	} {
+		w = do(t, h, http.MethodGet, "/users?"+query, "", e)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.Equal(t, "invalidRequest", e.Error.Code, query)
+	}
+
+	w = do(t, h, http.MethodPatch, "/users/"+ids["bob"], `{"displayName": "Robert", "accountEnabled": false}`, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	u := map[string]interface{}{}
+	w = do(t, h, http.MethodGet, "/users/"+ids["bob"]+"?$select=displayName,accountEnabled", "", &u)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Robert", u["displayName"])
+	assert.Equal(t, false, u["accountEnabled"])
+	assert.NotContains(t, u, "id")
+
+	w = do(t, h, http.MethodPatch, "/users/"+ids["bob"], `{"createdDateTime": "2020-01-01T00:00:00Z"}`, e)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	revoked := map[string]interface{}{}
+	w = do(t, h, http.MethodPost, "/users/"+ids["alice"]+"/revokeSignInSessions", "", &revoked)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, true, revoked["value"])
+	u = map[string]interface{}{}
+	w = do(t, h, http.MethodGet, "/users/"+ids["alice"]+"?$select=signInSessionsValidFromDateTime", "", &u)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotNil(t, u["signInSessionsValidFromDateTime"])
+
+	g := map[string]interface{}{}
+	w = do(t, h, http.MethodPost, "/groups", `{"displayName": "graph-staff", "description": "Staff"}`, &g)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	groupID := g["id"].(string)
+
+	w = do(t, h, http.MethodPost, "/groups/"+groupID+"/members/$ref", `{"@odata.id": "https://graph.microsoft.com/v1.0/directoryObjects/`+ids["alice"]+`"}`, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = do(t, h, http.MethodPost, "/groups/"+groupID+"/members/$ref", `{"@odata.id": "https://graph.microsoft.com/v1.0/directoryObjects/does-not-exist"}`, e)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	list = &collection{}
+	w = do(t, h, http.MethodGet, "/groups/"+groupID+"/members", "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, list.Value, 1)
+	assert.Equal(t, ids["alice"], list.Value[0]["id"])
+	assert.Equal(t, "#microsoft.graph.user", list.Value[0]["@odata.type"])
+
+	list = &collection{}
+	w = do(t, h, http.MethodGet, "/users/"+ids["alice"]+"/memberOf", "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, list.Value, 1)
+	assert.Equal(t, groupID, list.Value[0]["id"])
+
+	w = do(t, h, http.MethodPatch, "/groups/"+groupID, `{"displayName": "graph-team"}`, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	list = &collection{}
+	w = do(t, h, http.MethodGet, "/groups?$filter="+url.QueryEscape("startswith(displayName,'graph-')"), "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, list.Value, 1)
+	assert.Equal(t, "graph-team", list.Value[0]["displayName"])
+
+	w = do(t, h, http.MethodDelete, "/groups/"+groupID+"/members/"+ids["alice"]+"/$ref", "", nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = do(t, h, http.MethodDelete, "/groups/"+groupID+"/members/"+ids["alice"]+"/$ref", "", e)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = do(t, h, http.MethodPost, "/groups/"+groupID+"/owners/$ref", `{"@odata.id": "https://graph.microsoft.com/v1.0/directoryObjects/`+ids["bob"]+`"}`, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	list = &collection{}
+	w = do(t, h, http.MethodGet, "/groups/"+groupID+"/owners", "", list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, list.Value, 1)
+	assert.Equal(t, ids["bob"], list.Value[0]["id"])
+	w = do(t, h, http.MethodDelete, "/groups/"+groupID+"/owners/"+ids["bob"]+"/$ref", "", nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = do(t, h, http.MethodDelete, "/groups/"+groupID+"/owners/"+ids["bob"]+"/$ref", "", e)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = do(t, h, http.MethodDelete, "/groups/"+groupID, "", nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	w = do(t, h, http.MethodDelete, "/users/"+ids["carol"], "", nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	w = do(t, h, http.MethodGet, "/users/"+ids["carol"], "", e)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "itemNotFound", e.Error.Code)
+}
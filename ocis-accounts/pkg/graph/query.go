@@ -0,0 +1,279 @@
+package graph
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultPageSize is used when no $top is given, like the graph api does for users and groups
+	defaultPageSize = 100
+	// maxPageSize is the largest supported value for $top
+	maxPageSize = 999
+)
+
+// entitySet describes the properties of a collection that can be used in $filter and $orderby
+type entitySet struct {
+	name string
+	// filter maps the graph properties to the properties of the index
+	filter map[string]string
+	// order returns the value a message is sorted by for a graph property
+	order map[string]func(m gproto.Message) string
+}
+
+var users = entitySet{
+	name: "users",
+	filter: map[string]string{
+		"id":                       "id",
+		"accountEnabled":           "account_enabled",
+		"displayName":              "display_name",
+		"preferredName":            "preferred_name",
+		"onPremisesSamAccountName": "on_premises_sam_account_name",
+		"onPremisesImmutableId":    "on_premises_immutable_id",
+		"mail":                     "mail",
+		"description":              "description",
+		"userType":                 "user_type",
+		"externalUserState":        "external_user_state",
+		"uidNumber":                "uid_number",
+		"gidNumber":                "gid_number",
+		"createdDateTime":          "created_date_time",
+		"lastModifiedDateTime":     "last_modified_date_time",
+		"expirationDateTime":       "expiration_date_time",
+		"scheduledEnableDateTime":  "scheduled_enable_date_time",
+	},
+	order: map[string]func(m gproto.Message) string{
+		"id":                       func(m gproto.Message) string { return m.(*proto.Account).Id },
+		"displayName":              func(m gproto.Message) string { return strings.ToLower(m.(*proto.Account).DisplayName) },
+		"preferredName":            func(m gproto.Message) string { return strings.ToLower(m.(*proto.Account).PreferredName) },
+		"onPremisesSamAccountName": func(m gproto.Message) string { return strings.ToLower(m.(*proto.Account).OnPremisesSamAccountName) },
+		"mail":                     func(m gproto.Message) string { return strings.ToLower(m.(*proto.Account).Mail) },
+		"uidNumber":                func(m gproto.Message) string { return sortableNumber(m.(*proto.Account).UidNumber) },
+		"createdDateTime":          func(m gproto.Message) string { return sortableTime(m.(*proto.Account).CreatedDateTime) },
+		"expirationDateTime":       func(m gproto.Message) string { return sortableTime(m.(*proto.Account).ExpirationDateTime) },
+	},
+}
+
+var groups = entitySet{
+	name: "groups",
+	filter: map[string]string{
+		"id":                       "id",
+		"displayName":              "display_name",
+		"onPremisesSamAccountName": "on_premises_sam_account_name",
+		"onPremisesImmutableId":    "on_premises_immutable_id",
+		"description":              "description",
+		"gidNumber":                "gid_number",
+		"createdDateTime":          "created_date_time",
+	},
+	order: map[string]func(m gproto.Message) string{
+		"id":                       func(m gproto.Message) string { return m.(*proto.Group).Id },
+		"displayName":              func(m gproto.Message) string { return strings.ToLower(m.(*proto.Group).DisplayName) },
+		"onPremisesSamAccountName": func(m gproto.Message) string { return strings.ToLower(m.(*proto.Group).OnPremisesSamAccountName) },
+		"gidNumber":                func(m gproto.Message) string { return sortableNumber(m.(*proto.Group).GidNumber) },
+		"createdDateTime":          func(m gproto.Message) string { return sortableTime(m.(*proto.Group).CreatedDateTime) },
+	},
+}
+
+// filterKeywords are the operators and literals of odata filters, they are passed through unchanged
+var filterKeywords = map[string]struct{}{
+	"eq": {}, "ne": {}, "gt": {}, "ge": {}, "lt": {}, "le": {},
+	"and": {}, "or": {}, "not": {},
+	"true": {}, "false": {}, "null": {},
+}
+
+// filterFunctions are the supported odata functions
+var filterFunctions = map[string]struct{}{
+	"startswith": {}, "endswith": {}, "contains": {},
+}
+
+// translateFilter replaces the graph property names in an odata filter with the properties of the index.
+// Only the properties of the entity set can be used, so filters can not be used to probe other data like passwords.
+func translateFilter(filter string, properties map[string]string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == '\'':
+			// string literals escape quotes by doubling them
+			j := i + 1
+			for ; j < len(filter); j++ {
+				if filter[j] == '\'' {
+					if j+1 < len(filter) && filter[j+1] == '\'' {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(filter) {
+				return "", fmt.Errorf("unterminated string at position %d", i)
+			}
+			b.WriteString(filter[i : j+1])
+			i = j + 1
+		case isDigit(c) || c == '-':
+			// numbers and datetime literals like 2020-01-01T00:00:00Z
+			j := i + 1
+			for ; j < len(filter) && (isLetter(filter[j]) || isDigit(filter[j]) || strings.IndexByte(":.+-", filter[j]) >= 0); j++ {
+			}
+			b.WriteString(filter[i:j])
+			i = j
+		case isLetter(c):
+			j := i + 1
+			for ; j < len(filter) && (isLetter(filter[j]) || isDigit(filter[j])); j++ {
+			}
+			word := filter[i:j]
+			i = j
+			if _, ok := filterKeywords[word]; ok {
+				b.WriteString(word)
+				continue
+			}
+			if _, ok := filterFunctions[word]; ok && strings.HasPrefix(strings.TrimLeft(filter[i:], " "), "(") {
+				b.WriteString(word)
+				continue
+			}
+			property, ok := properties[word]
+			if !ok {
+				return "", fmt.Errorf("property '%s' can not be used in filters", word)
+			}
+			if i < len(filter) && filter[i] == '/' {
+				return "", fmt.Errorf("navigating from '%s' is not supported", word)
+			}
+			b.WriteString(property)
+		case strings.IndexByte(" (),", c) >= 0:
+			b.WriteByte(c)
+			i++
+		default:
+			return "", fmt.Errorf("unexpected '%c' at position %d", c, i)
+		}
+	}
+	return b.String(), nil
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// orderClause is a single property of an $orderby parameter
+type orderClause struct {
+	property string
+	desc     bool
+}
+
+// parseOrderBy parses a comma separated list of properties that are optionally followed by asc or desc
+func parseOrderBy(orderBy string, set entitySet) ([]orderClause, error) {
+	clauses := []orderClause{}
+	for _, part := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("invalid $orderby clause '%s'", strings.TrimSpace(part))
+		}
+		if _, ok := set.order[fields[0]]; !ok {
+			return nil, fmt.Errorf("%s can not be ordered by '%s'", set.name, fields[0])
+		}
+		clause := orderClause{property: fields[0]}
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				clause.desc = true
+			default:
+				return nil, fmt.Errorf("invalid $orderby direction '%s'", fields[1])
+			}
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses, nil
+}
+
+// sortMessages sorts the messages by the clauses, messages with equal values keep their order
+func sortMessages(messages []gproto.Message, clauses []orderClause, set entitySet) {
+	sort.SliceStable(messages, func(i, j int) bool {
+		for _, c := range clauses {
+			key := set.order[c.property]
+			a, b := key(messages[i]), key(messages[j])
+			if a == b {
+				continue
+			}
+			if c.desc {
+				return a > b
+			}
+			return a < b
+		}
+		return false
+	})
+}
+
+// sortableNumber pads numbers so they can be compared as strings
+func sortableNumber(n int64) string {
+	return fmt.Sprintf("%020d", n)
+}
+
+// sortableTime formats timestamps with a fixed width so they can be compared as strings
+func sortableTime(t *timestamp.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().UTC().Format("2006-01-02T15:04:05.000000000Z")
+}
+
+// page returns the offset and size of the requested page. The $skiptoken is an opaque token that has been returned in
+// the @odata.nextLink of the previous page.
+func page(r *http.Request) (offset, size int, err error) {
+	size = defaultPageSize
+	if top := r.URL.Query().Get("$top"); top != "" {
+		if size, err = strconv.Atoi(top); err != nil || size < 1 || size > maxPageSize {
+			return 0, 0, badRequest("$top must be a number between 1 and %d", maxPageSize)
+		}
+	}
+	if token := r.URL.Query().Get("$skiptoken"); token != "" {
+		if offset, err = decodeSkipToken(token); err != nil {
+			return 0, 0, badRequest("invalid $skiptoken")
+		}
+	}
+	return offset, size, nil
+}
+
+func encodeSkipToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeSkipToken(token string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := strconv.Atoi(string(data))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid offset")
+	}
+	return offset, nil
+}
+
+// parseSelect returns the properties listed in the $select parameter, nil selects all properties
+func parseSelect(r *http.Request, m gproto.Message) (map[string]struct{}, error) {
+	value := r.URL.Query().Get("$select")
+	if value == "" {
+		return nil, nil
+	}
+	fields := m.ProtoReflect().Descriptor().Fields()
+	selected := map[string]struct{}{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if fields.ByJSONName(name) == nil {
+			return nil, badRequest("could not find a property named '%s'", name)
+		}
+		selected[name] = struct{}{}
+	}
+	return selected, nil
+}
@@ -0,0 +1,211 @@
+package ldap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ber "github.com/nmcclain/asn1-ber"
+	ldapserver "github.com/nmcclain/ldap"
+)
+
+// kind describes how the attributes of an entry type map to the properties of the index
+type kind struct {
+	// objectClasses are the lowercased object classes of the entries
+	objectClasses map[string]struct{}
+	// equality maps lowercased attributes to the properties that are matched case insensitively. Values are compared
+	// with match queries, so the results may contain entries with a different value.
+	equality map[string][]string
+	// substrings maps lowercased attributes to properties that are indexed lowercased as a single term
+	substrings map[string][]string
+	// numeric maps lowercased attributes to numeric properties
+	numeric map[string]string
+}
+
+var userKind = kind{
+	objectClasses: map[string]struct{}{"top": {}, "person": {}, "organizationalperson": {}, "inetorgperson": {}, "posixaccount": {}},
+	equality: map[string][]string{
+		"cn":        {"on_premises_sam_account_name", "preferred_name"},
+		"uid":       {"on_premises_sam_account_name", "preferred_name"},
+		"entryuuid": {"id"},
+	},
+	substrings: map[string][]string{
+		"cn":  {"on_premises_sam_account_name", "preferred_name"},
+		"uid": {"on_premises_sam_account_name", "preferred_name"},
+	},
+	numeric: map[string]string{
+		"uidnumber": "uid_number",
+		"gidnumber": "gid_number",
+	},
+}
+
+var groupKind = kind{
+	objectClasses: map[string]struct{}{"top": {}, "posixgroup": {}, "groupofnames": {}},
+	equality: map[string][]string{
+		"cn":        {"on_premises_sam_account_name", "display_name"},
+		"entryuuid": {"id"},
+	},
+	substrings: map[string][]string{},
+	numeric: map[string]string{
+		"gidnumber": "gid_number",
+	},
+}
+
+// narrowing is the odata query that preselects the candidates for an ldap filter. The filter itself is applied to the
+// resulting entries by the server, so the query only has to find a superset of the matching entries. all is set if
+// the filter can not be narrowed down, none if no entry of the kind can match.
+type narrowing struct {
+	query string
+	all   bool
+	none  bool
+}
+
+// translateFilter converts an ldap filter to a query that finds a superset of the entries of the kind matching it
+func translateFilter(f *ber.Packet, k kind) (narrowing, error) {
+	switch f.Tag {
+	case ldapserver.FilterAnd:
+		queries := []string{}
+		for _, child := range f.Children {
+			n, err := translateFilter(child, k)
+			if err != nil {
+				return narrowing{}, err
+			}
+			if n.none {
+				return narrowing{none: true}, nil
+			}
+			if !n.all {
+				queries = append(queries, n.query)
+			}
+		}
+		return join(queries, " and "), nil
+	case ldapserver.FilterOr:
+		queries := []string{}
+		for _, child := range f.Children {
+			n, err := translateFilter(child, k)
+			if err != nil {
+				return narrowing{}, err
+			}
+			if n.all {
+				return narrowing{all: true}, nil
+			}
+			if !n.none {
+				queries = append(queries, n.query)
+			}
+		}
+		if len(queries) == 0 {
+			return narrowing{none: true}, nil
+		}
+		return join(queries, " or "), nil
+	case ldapserver.FilterNot:
+		// the negation of a superset is no superset of the negation
+		return narrowing{all: true}, nil
+	case ldapserver.FilterEqualityMatch, ldapserver.FilterApproxMatch:
+		attribute, value, err := assertion(f)
+		if err != nil {
+			return narrowing{}, err
+		}
+		if attribute == "objectclass" {
+			_, ok := k.objectClasses[strings.ToLower(value)]
+			return narrowing{none: !ok, all: ok}, nil
+		}
+		if attribute == "entryuuid" {
+			// ids are generated as lowercase uuids
+			value = strings.ToLower(value)
+		}
+		if properties, ok := k.equality[attribute]; ok {
+			queries := make([]string, 0, len(properties))
+			for _, p := range properties {
+				queries = append(queries, fmt.Sprintf("%s eq '%s'", p, escape(value)))
+			}
+			return join(queries, " or "), nil
+		}
+		if property, ok := k.numeric[attribute]; ok {
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return narrowing{none: true}, nil
+			}
+			return narrowing{query: fmt.Sprintf("%s eq %s", property, value)}, nil
+		}
+		return narrowing{all: true}, nil
+	case ldapserver.FilterGreaterOrEqual, ldapserver.FilterLessOrEqual:
+		attribute, value, err := assertion(f)
+		if err != nil {
+			return narrowing{}, err
+		}
+		property, ok := k.numeric[attribute]
+		if !ok {
+			return narrowing{all: true}, nil
+		}
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return narrowing{none: true}, nil
+		}
+		op := "ge"
+		if f.Tag == ldapserver.FilterLessOrEqual {
+			op = "le"
+		}
+		return narrowing{query: fmt.Sprintf("%s %s %s", property, op, value)}, nil
+	case ldapserver.FilterSubstrings:
+		if len(f.Children) != 2 || len(f.Children[1].Children) == 0 {
+			return narrowing{}, fmt.Errorf("invalid substrings filter")
+		}
+		attribute := strings.ToLower(packetString(f.Children[0]))
+		properties, ok := k.substrings[attribute]
+		if !ok {
+			return narrowing{all: true}, nil
+		}
+		queries := make([]string, 0, len(properties))
+		for _, p := range properties {
+			parts := []string{}
+			for _, s := range f.Children[1].Children {
+				function := "contains"
+				switch s.Tag {
+				case ldapserver.FilterSubstringsInitial:
+					function = "startswith"
+				case ldapserver.FilterSubstringsFinal:
+					function = "endswith"
+				}
+				// the properties are indexed lowercased and substring queries are not analyzed
+				parts = append(parts, fmt.Sprintf("%s(%s,'%s')", function, p, escape(strings.ToLower(packetString(s)))))
+			}
+			queries = append(queries, join(parts, " and ").query)
+		}
+		return join(queries, " or "), nil
+	default:
+		// presence and extensible matches are left to the server
+		return narrowing{all: true}, nil
+	}
+}
+
+// join combines the queries with the operator, no queries match all entries
+func join(queries []string, op string) narrowing {
+	switch len(queries) {
+	case 0:
+		return narrowing{all: true}
+	case 1:
+		return narrowing{query: queries[0]}
+	default:
+		return narrowing{query: "(" + strings.Join(queries, op) + ")"}
+	}
+}
+
+// assertion returns the lowercased attribute and the value of an attribute value assertion
+func assertion(f *ber.Packet) (string, string, error) {
+	if len(f.Children) != 2 {
+		return "", "", fmt.Errorf("invalid attribute value assertion")
+	}
+	return strings.ToLower(packetString(f.Children[0])), packetString(f.Children[1]), nil
+}
+
+func packetString(p *ber.Packet) string {
+	if s, ok := p.Value.(string); ok {
+		return s
+	}
+	if p.Data != nil {
+		return p.Data.String()
+	}
+	return ""
+}
+
+// escape doubles single quotes for odata string literals
+func escape(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,163 @@
+package ldap
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/micro/go-micro/v2/client"
+	ldapserver "github.com/nmcclain/ldap"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dataPath = "/var/tmp/ocis-accounts-ldap-tests"
+
+func TestTranslateFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		users  narrowing
+		groups narrowing
+	}{
+		{"(objectClass=*)", narrowing{all: true}, narrowing{all: true}},
+		{"(&(objectClass=posixAccount)(uid=alice))", narrowing{query: "(on_premises_sam_account_name eq 'alice' or preferred_name eq 'alice')"}, narrowing{none: true}},
+		{"(&(objectClass=posixGroup)(|(cn=staff)(gidNumber=30001)))", narrowing{none: true}, narrowing{query: "((on_premises_sam_account_name eq 'staff' or display_name eq 'staff') or gid_number eq 30001)"}},
+		{"(&(uidNumber>=20000)(uidNumber<=20010))", narrowing{query: "(uid_number ge 20000 and uid_number le 20010)"}, narrowing{all: true}},
+		{"(cn=O'Neil*)", narrowing{query: "(startswith(on_premises_sam_account_name,'o''neil') or startswith(preferred_name,'o''neil'))"}, narrowing{all: true}},
+		{"(cn=*a*e)", narrowing{query: "((contains(on_premises_sam_account_name,'a') and endswith(on_premises_sam_account_name,'e')) or (contains(preferred_name,'a') and endswith(preferred_name,'e')))"}, narrowing{all: true}},
+		{"(|(mail=alice@example.org)(uid=alice))", narrowing{all: true}, narrowing{all: true}},
+		{"(!(uid=alice))", narrowing{all: true}, narrowing{all: true}},
+		{"(gidNumber=abc)", narrowing{none: true}, narrowing{none: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			f, err := ldapserver.CompileFilter(tt.filter)
+			require.NoError(t, err)
+			users, err := translateFilter(f, userKind)
+			require.NoError(t, err)
+			assert.Equal(t, tt.users, users)
+			groups, err := translateFilter(f, groupKind)
+			require.NoError(t, err)
+			assert.Equal(t, tt.groups, groups)
+		})
+	}
+}
+
+func TestDN(t *testing.T) {
+	assert.Equal(t, "cn=alice,ou=users,dc=example,dc=org", normalizeDN(" CN=Alice, OU=Users,  DC=example,DC=org"))
+	assert.Equal(t, `doe\, jane\+\=`, escapeDN("doe, jane+="))
+	assert.Equal(t, "doe, jane+=", unescapeDN(`doe\, jane\+\=`))
+
+	s := NewServer(BaseDN("dc=example,dc=org"))
+	name, ok := s.entryName(`cn=doe\, jane,ou=users,dc=example,dc=org`, s.usersDN)
+	assert.True(t, ok)
+	assert.Equal(t, "doe, jane", name)
+	_, ok = s.entryName("cn=alice,ou=other,ou=users,dc=example,dc=org", s.usersDN)
+	assert.False(t, ok)
+}
+
+func TestServer(t *testing.T) {
+	defer os.RemoveAll(dataPath)
+
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = dataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	roleService := settings.MockRoleService{
+		AssignRoleToUserFunc: func(ctx context.Context, req *settings.AssignRoleToUserRequest, opts ...client.CallOption) (*settings.AssignRoleToUserResponse, error) {
+			return &settings.AssignRoleToUserResponse{Assignment: &settings.UserRoleAssignment{}}, nil
+		},
+	}
+	s, err := svc.New(svc.Logger(olog.NewLogger()), svc.Config(cfg), svc.RoleService(roleService))
+	require.NoError(t, err)
+
+	ctx := serviceContext()
+	ids := map[string]string{}
+	for _, name := range []string{"alice", "bob"} {
+		a := &proto.Account{}
+		require.NoError(t, s.CreateAccount(ctx, &proto.CreateAccountRequest{Account: &proto.Account{
+			AccountEnabled:           name == "alice",
+			DisplayName:              name + " example",
+			PreferredName:            name,
+			OnPremisesSamAccountName: name,
+			Mail:                     name + "@example.org",
+			PasswordProfile:          &proto.PasswordProfile{Password: "Secret123!"},
+		}}, a))
+		ids[name] = a.Id
+	}
+	g := &proto.Group{}
+	require.NoError(t, s.CreateGroup(ctx, &proto.CreateGroupRequest{Group: &proto.Group{DisplayName: "staff", OnPremisesSamAccountName: "staff"}}, g))
+	require.NoError(t, s.AddMember(ctx, &proto.AddMemberRequest{GroupId: g.Id, AccountId: ids["alice"]}, &proto.Group{}))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	serverCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	server := NewServer(Logger(olog.NewLogger()), AccountsService(s), GroupsService(s))
+	go server.Serve(serverCtx, ln)
+
+	conn, err := ldap.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	search := func(base string, scope int, filter string, attributes ...string) (*ldap.SearchResult, error) {
+		return conn.Search(ldap.NewSearchRequest(base, scope, ldap.NeverDerefAliases, 0, 0, false, filter, attributes, nil))
+	}
+
+	// anonymous connections can not search
+	_, err = search("dc=example,dc=org", ldap.ScopeWholeSubtree, "(objectClass=*)")
+	assert.True(t, ldap.IsErrorWithCode(err, ldap.LDAPResultInsufficientAccessRights), err)
+
+	assert.True(t, ldap.IsErrorWithCode(conn.Bind("cn=alice,ou=users,dc=example,dc=org", "wrong"), ldap.LDAPResultInvalidCredentials))
+	assert.True(t, ldap.IsErrorWithCode(conn.Bind("cn=bob,ou=users,dc=example,dc=org", "Secret123!"), ldap.LDAPResultInvalidCredentials), "disabled accounts can not bind")
+	assert.True(t, ldap.IsErrorWithCode(conn.Bind("cn=alice,ou=groups,dc=example,dc=org", "Secret123!"), ldap.LDAPResultInvalidCredentials))
+	require.NoError(t, conn.Bind("uid=Alice, ou=users, dc=example, dc=org", "Secret123!"))
+
+	res, err := search("ou=users,dc=example,dc=org", ldap.ScopeWholeSubtree, "(&(objectClass=posixAccount)(uid=alice))")
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	alice := res.Entries[0]
+	assert.Equal(t, "cn=alice,ou=users,dc=example,dc=org", alice.DN)
+	assert.Equal(t, ids["alice"], alice.GetAttributeValue("entryUUID"))
+	assert.Equal(t, "alice@example.org", alice.GetAttributeValue("mail"))
+	assert.Equal(t, "/home/alice", alice.GetAttributeValue("homeDirectory"))
+	assert.NotEmpty(t, alice.GetAttributeValue("uidNumber"))
+	assert.Equal(t, []string{"cn=staff,ou=groups,dc=example,dc=org"}, alice.GetAttributeValues("memberOf"))
+
+	// the filter is applied exactly, the preselection only narrows the candidates down
+	res, err = search("dc=example,dc=org", ldap.ScopeWholeSubtree, "(&(objectClass=inetOrgPerson)(mail=BOB@example.org))", "uid")
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	assert.Equal(t, "bob", res.Entries[0].GetAttributeValue("uid"))
+
+	res, err = search("dc=example,dc=org", ldap.ScopeWholeSubtree, "(uid=ali*)")
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+
+	res, err = search("dc=example,dc=org", ldap.ScopeWholeSubtree, "(&(objectClass=posixGroup)(memberUid=alice))")
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	assert.Equal(t, "cn=staff,ou=groups,dc=example,dc=org", res.Entries[0].DN)
+	assert.Equal(t, g.Id, res.Entries[0].GetAttributeValue("entryUUID"))
+	assert.Equal(t, []string{"cn=alice,ou=users,dc=example,dc=org"}, res.Entries[0].GetAttributeValues("member"))
+
+	res, err = search("cn=bob,ou=users,dc=example,dc=org", ldap.ScopeBaseObject, "(objectClass=*)")
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	assert.Equal(t, ids["bob"], res.Entries[0].GetAttributeValue("entryUUID"))
+
+	res, err = search("dc=example,dc=org", ldap.ScopeSingleLevel, "(objectClass=organizationalUnit)")
+	require.NoError(t, err)
+	assert.Len(t, res.Entries, 2)
+
+	_, err = search("dc=other,dc=org", ldap.ScopeWholeSubtree, "(objectClass=*)")
+	assert.True(t, ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject), err)
+}
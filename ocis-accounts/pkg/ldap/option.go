@@ -0,0 +1,76 @@
+package ldap
+
+import (
+	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Logger          log.Logger
+	Addr            string
+	BaseDN          string
+	CertFile        string
+	KeyFile         string
+	AccountsService proto.AccountsServiceHandler
+	GroupsService   proto.GroupsServiceHandler
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		BaseDN: "dc=example,dc=org",
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// Logger provides a function to set the logger option.
+func Logger(val log.Logger) Option {
+	return func(o *Options) {
+		o.Logger = val
+	}
+}
+
+// Addr provides a function to set the address the server listens on.
+func Addr(val string) Option {
+	return func(o *Options) {
+		o.Addr = val
+	}
+}
+
+// BaseDN provides a function to set the base dn of the directory, users and groups are placed below it.
+func BaseDN(val string) Option {
+	return func(o *Options) {
+		o.BaseDN = val
+	}
+}
+
+// TLS provides a function to set the certificate and key, the server only accepts tls connections when they are set.
+func TLS(certFile, keyFile string) Option {
+	return func(o *Options) {
+		o.CertFile = certFile
+		o.KeyFile = keyFile
+	}
+}
+
+// AccountsService provides a function to set the accounts service option.
+func AccountsService(val proto.AccountsServiceHandler) Option {
+	return func(o *Options) {
+		o.AccountsService = val
+	}
+}
+
+// GroupsService provides a function to set the groups service option.
+func GroupsService(val proto.GroupsServiceHandler) Option {
+	return func(o *Options) {
+		o.GroupsService = val
+	}
+}
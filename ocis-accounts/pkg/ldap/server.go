@@ -0,0 +1,375 @@
+// Package ldap implements a small LDAPv3 server that exposes the accounts and groups as inetOrgPerson, posixAccount and
+// posixGroup entries. Users are placed below ou=users and groups below ou=groups of the configured base dn.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	ber "github.com/nmcclain/asn1-ber"
+	ldapserver "github.com/nmcclain/ldap"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// Server answers bind and search requests with the accounts and groups of the services
+type Server struct {
+	Options
+	baseDN   string
+	usersDN  string
+	groupsDN string
+}
+
+// NewServer initializes a new ldap server.
+func NewServer(opts ...Option) *Server {
+	options := newOptions(opts...)
+	base := normalizeDN(options.BaseDN)
+	return &Server{
+		Options:  options,
+		baseDN:   base,
+		usersDN:  "ou=users," + base,
+		groupsDN: "ou=groups," + base,
+	}
+}
+
+// Run listens on the configured address until the context is done. Connections use tls when a certificate is set.
+func (s *Server) Run(ctx context.Context) error {
+	var ln net.Listener
+	var err error
+	if s.CertFile != "" {
+		var cert tls.Certificate
+		if cert, err = tls.LoadX509KeyPair(s.CertFile, s.KeyFile); err != nil {
+			return err
+		}
+		ln, err = tls.Listen("tcp", s.Addr, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	} else {
+		ln, err = net.Listen("tcp", s.Addr)
+	}
+	if err != nil {
+		return err
+	}
+	s.Logger.Info().Str("addr", s.Addr).Str("base_dn", s.baseDN).Msg("ldap server listening")
+	return s.Serve(ctx, ln)
+}
+
+// Serve handles the connections of the listener until the context is done
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	server := ldapserver.NewServer()
+	// the server applies the filter, scope and requested attributes to the entries returned by Search
+	server.EnforceLDAP = true
+	server.BindFunc("", s)
+	server.SearchFunc("", s)
+
+	quit := make(chan bool)
+	server.QuitChannel(quit)
+	go func() {
+		<-ctx.Done()
+		close(quit)
+	}()
+	return server.Serve(ln)
+}
+
+// serviceContext authorizes the calls to the services. Binds are checked by the accounts service and searches need a
+// successful bind, so the ldap server acts on its own behalf.
+func serviceContext() context.Context {
+	return auth.NewContext(context.Background(), auth.Principal{Service: "ldap"})
+}
+
+// Bind authenticates users with the dn of their entry, e.g. cn=alice,ou=users,dc=example,dc=org. Anonymous binds
+// succeed, but anonymous connections can not search.
+func (s *Server) Bind(bindDN, bindSimplePw string, conn net.Conn) (ldapserver.LDAPResultCode, error) {
+	if bindDN == "" && bindSimplePw == "" {
+		return ldapserver.LDAPResultSuccess, nil
+	}
+	name, ok := s.entryName(normalizeDN(bindDN), s.usersDN)
+	if !ok || bindSimplePw == "" {
+		return ldapserver.LDAPResultInvalidCredentials, nil
+	}
+
+	// the accounts service treats this query as a login
+	res := &proto.ListAccountsResponse{}
+	if err := s.AccountsService.ListAccounts(serviceContext(), &proto.ListAccountsRequest{
+		Query: fmt.Sprintf("login eq '%s' and password eq '%s'", escape(name), bindSimplePw),
+	}, res); err != nil {
+		if merrors.Parse(err.Error()).Code == http.StatusUnauthorized {
+			return ldapserver.LDAPResultInvalidCredentials, nil
+		}
+		s.Logger.Error().Err(err).Str("dn", bindDN).Msg("could not authenticate ldap bind")
+		return ldapserver.LDAPResultOperationsError, err
+	}
+	if len(res.Accounts) != 1 || !res.Accounts[0].AccountEnabled {
+		return ldapserver.LDAPResultInvalidCredentials, nil
+	}
+	return ldapserver.LDAPResultSuccess, nil
+}
+
+// Search returns the entries below the base dn of the request that may match the filter
+func (s *Server) Search(boundDN string, req ldapserver.SearchRequest, conn net.Conn) (ldapserver.ServerSearchResult, error) {
+	if boundDN == "" {
+		return ldapserver.ServerSearchResult{ResultCode: ldapserver.LDAPResultInsufficientAccessRights}, fmt.Errorf("anonymous search of %s", req.BaseDN)
+	}
+	filter, err := ldapserver.CompileFilter(req.Filter)
+	if err != nil {
+		return ldapserver.ServerSearchResult{ResultCode: ldapserver.LDAPResultOperationsError}, err
+	}
+	base := normalizeDN(req.BaseDN)
+	if !under(base, s.baseDN) && !under(s.baseDN, base) {
+		return ldapserver.ServerSearchResult{ResultCode: ldapserver.LDAPResultNoSuchObject}, fmt.Errorf("%s is not part of the directory", req.BaseDN)
+	}
+
+	entries := []*ldapserver.Entry{}
+	for _, e := range s.containers() {
+		if under(normalizeDN(e.DN), base) {
+			entries = append(entries, e)
+		}
+	}
+
+	if under(s.usersDN, base) || under(base, s.usersDN) {
+		users, err := s.searchUsers(filter, base)
+		if err != nil {
+			return ldapserver.ServerSearchResult{ResultCode: ldapserver.LDAPResultOperationsError}, err
+		}
+		entries = append(entries, users...)
+	}
+	if under(s.groupsDN, base) || under(base, s.groupsDN) {
+		groups, err := s.searchGroups(filter, base)
+		if err != nil {
+			return ldapserver.ServerSearchResult{ResultCode: ldapserver.LDAPResultOperationsError}, err
+		}
+		entries = append(entries, groups...)
+	}
+	return ldapserver.ServerSearchResult{Entries: entries, ResultCode: ldapserver.LDAPResultSuccess}, nil
+}
+
+func (s *Server) searchUsers(filter *ber.Packet, base string) ([]*ldapserver.Entry, error) {
+	query, ok, err := s.query(filter, userKind, base, s.usersDN)
+	if err != nil || !ok {
+		return nil, err
+	}
+	res := &proto.ListAccountsResponse{}
+	if err := s.AccountsService.ListAccounts(serviceContext(), &proto.ListAccountsRequest{Query: query}, res); err != nil {
+		return nil, err
+	}
+	entries := make([]*ldapserver.Entry, 0, len(res.Accounts))
+	for _, a := range res.Accounts {
+		e := s.userEntry(a)
+		if under(normalizeDN(e.DN), base) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (s *Server) searchGroups(filter *ber.Packet, base string) ([]*ldapserver.Entry, error) {
+	query, ok, err := s.query(filter, groupKind, base, s.groupsDN)
+	if err != nil || !ok {
+		return nil, err
+	}
+	res := &proto.ListGroupsResponse{}
+	if err := s.GroupsService.ListGroups(serviceContext(), &proto.ListGroupsRequest{Query: query}, res); err != nil {
+		return nil, err
+	}
+	entries := make([]*ldapserver.Entry, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		if g.DeletedDateTime != nil {
+			continue
+		}
+		e := s.groupEntry(g)
+		if under(normalizeDN(e.DN), base) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// query returns the odata query for the entries of the kind below the container that may match the filter. Searches
+// with the dn of a single entry as their base are narrowed down to its name. ok is false if no entry can match.
+func (s *Server) query(filter *ber.Packet, k kind, base, container string) (query string, ok bool, err error) {
+	n, err := translateFilter(filter, k)
+	if err != nil || n.none {
+		return "", false, err
+	}
+	queries := []string{}
+	if !n.all {
+		queries = append(queries, n.query)
+	}
+	if base != container && under(base, container) {
+		name, ok := s.entryName(base, container)
+		if !ok {
+			return "", false, nil
+		}
+		names := []string{}
+		for _, p := range k.equality["cn"] {
+			names = append(names, fmt.Sprintf("%s eq '%s'", p, escape(name)))
+		}
+		queries = append(queries, join(names, " or ").query)
+	}
+	return strings.Join(queries, " and "), true, nil
+}
+
+// entryName returns the name of the direct child of the container with the given normalized dn
+func (s *Server) entryName(dn, container string) (string, bool) {
+	if !strings.HasSuffix(dn, ","+container) {
+		return "", false
+	}
+	rdn := strings.TrimSuffix(dn, ","+container)
+	parts := strings.SplitN(rdn, "=", 2)
+	if len(parts) != 2 || (parts[0] != "cn" && parts[0] != "uid") || strings.Contains(escapeSequences.ReplaceAllString(parts[1], ""), ",") {
+		return "", false
+	}
+	return unescapeDN(parts[1]), true
+}
+
+// containers returns the base entry and the organizational units for users and groups
+func (s *Server) containers() []*ldapserver.Entry {
+	rdn := strings.SplitN(strings.SplitN(s.BaseDN, ",", 2)[0], "=", 2)
+	base := map[string][]string{"objectClass": {"top"}}
+	if len(rdn) == 2 {
+		base[strings.TrimSpace(rdn[0])] = []string{strings.TrimSpace(rdn[1])}
+	}
+	return []*ldapserver.Entry{
+		entry(s.baseDN, base),
+		entry(s.usersDN, map[string][]string{"objectClass": {"top", "organizationalUnit"}, "ou": {"users"}}),
+		entry(s.groupsDN, map[string][]string{"objectClass": {"top", "organizationalUnit"}, "ou": {"groups"}}),
+	}
+}
+
+func (s *Server) userEntry(a *proto.Account) *ldapserver.Entry {
+	name := userName(a)
+	groups := make([]string, 0, len(a.MemberOf))
+	for _, g := range a.MemberOf {
+		groups = append(groups, s.groupDN(g))
+	}
+	sn := a.DisplayName
+	if sn == "" {
+		sn = name
+	}
+	return entry(s.userDN(a), map[string][]string{
+		"objectClass":   {"top", "person", "organizationalPerson", "inetOrgPerson", "posixAccount"},
+		"cn":            {name},
+		"uid":           {name},
+		"sn":            {sn},
+		"displayName":   {a.DisplayName},
+		"mail":          {a.Mail},
+		"description":   {a.Description},
+		"uidNumber":     {number(a.UidNumber)},
+		"gidNumber":     {number(a.GidNumber)},
+		"homeDirectory": {"/home/" + name},
+		"entryUUID":     {a.Id},
+		"memberOf":      groups,
+	})
+}
+
+func (s *Server) groupEntry(g *proto.Group) *ldapserver.Entry {
+	members := make([]string, 0, len(g.Members))
+	memberUids := make([]string, 0, len(g.Members))
+	for _, a := range g.Members {
+		members = append(members, s.userDN(a))
+		memberUids = append(memberUids, userName(a))
+	}
+	return entry(s.groupDN(g), map[string][]string{
+		"objectClass": {"top", "groupOfNames", "posixGroup"},
+		"cn":          {groupName(g)},
+		"description": {g.Description},
+		"gidNumber":   {number(g.GidNumber)},
+		"entryUUID":   {g.Id},
+		"member":      members,
+		"memberUid":   memberUids,
+	})
+}
+
+func (s *Server) userDN(a *proto.Account) string {
+	return "cn=" + escapeDN(userName(a)) + "," + s.usersDN
+}
+
+func (s *Server) groupDN(g *proto.Group) string {
+	return "cn=" + escapeDN(groupName(g)) + "," + s.groupsDN
+}
+
+// userName returns the login of the account
+func userName(a *proto.Account) string {
+	if a.OnPremisesSamAccountName != "" {
+		return a.OnPremisesSamAccountName
+	}
+	return a.PreferredName
+}
+
+func groupName(g *proto.Group) string {
+	if g.OnPremisesSamAccountName != "" {
+		return g.OnPremisesSamAccountName
+	}
+	return g.DisplayName
+}
+
+func number(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// entry creates an entry with the non empty attribute values, the attributes are sorted by name
+func entry(dn string, attributes map[string][]string) *ldapserver.Entry {
+	e := &ldapserver.Entry{DN: dn}
+	for name, values := range attributes {
+		nonEmpty := []string{}
+		for _, v := range values {
+			if v != "" {
+				nonEmpty = append(nonEmpty, v)
+			}
+		}
+		if len(nonEmpty) > 0 {
+			e.Attributes = append(e.Attributes, &ldapserver.EntryAttribute{Name: name, Values: nonEmpty})
+		}
+	}
+	sort.Slice(e.Attributes, func(i, j int) bool { return e.Attributes[i].Name < e.Attributes[j].Name })
+	return e
+}
+
+var (
+	spaceAfterSeparator = regexp.MustCompile(`,\s+`)
+	escapeSequences     = regexp.MustCompile(`\\.`)
+	dnEscaper           = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `+`, `\+`, `"`, `\"`, `<`, `\<`, `>`, `\>`, `;`, `\;`, `=`, `\=`)
+)
+
+// normalizeDN lowercases the dn and removes the spaces after the rdn separators
+func normalizeDN(dn string) string {
+	return strings.ToLower(spaceAfterSeparator.ReplaceAllString(strings.TrimSpace(dn), ","))
+}
+
+// under checks if the normalized dn is equal to or below the parent
+func under(dn, parent string) bool {
+	return dn == parent || strings.HasSuffix(dn, ","+parent)
+}
+
+// escapeDN escapes the special characters of an attribute value in a dn
+func escapeDN(value string) string {
+	value = dnEscaper.Replace(value)
+	if strings.HasPrefix(value, "#") || strings.HasPrefix(value, " ") {
+		value = `\` + value
+	}
+	if strings.HasSuffix(value, " ") {
+		value = value[:len(value)-1] + `\ `
+	}
+	return value
+}
+
+// unescapeDN removes the escaping backslashes from an attribute value in a dn
+func unescapeDN(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
@@ -1,5 +1,13 @@
 package metrics
 
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
 var (
 	// Namespace defines the namespace for the defines metrics.
 	Namespace = "ocis"
@@ -8,15 +16,125 @@ var (
 	Subsystem = "accounts"
 )
 
-// Metrics defines the available metrics of this service.
+var (
+	// registerOnce guards the registration of the metrics, the default prometheus registry rejects duplicates
+	registerOnce sync.Once
+	registered   *Metrics
+)
+
+// Metrics defines the available metrics of this service. All methods can be called on a nil *Metrics, which records
+// nothing.
 type Metrics struct {
-	// Counter  *prometheus.CounterVec
+	Requests        *prometheus.CounterVec
+	RequestDuration *prometheus.HistogramVec
+	Authentications *prometheus.CounterVec
+	IndexDocuments  *prometheus.GaugeVec
+	ReindexDuration prometheus.Gauge
+	StorageDuration *prometheus.HistogramVec
 }
 
-// New initializes the available metrics.
+// New initializes the available metrics and registers them with the default prometheus registry. The metrics are
+// registered once, every call returns the same instance.
 func New() *Metrics {
-	m := &Metrics{
+	registerOnce.Do(func() {
+		registered = newMetrics()
+		prometheus.MustRegister(
+			registered.Requests,
+			registered.RequestDuration,
+			registered.Authentications,
+			registered.IndexDocuments,
+			registered.ReindexDuration,
+			registered.StorageDuration,
+		)
+	})
+	return registered
+}
+
+// newMetrics creates the collectors without registering them
+func newMetrics() *Metrics {
+	return &Metrics{
+		Requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "requests_total",
+			Help:      "Number of handled requests by transport, method and status code",
+		}, []string{"transport", "method", "code"}),
+		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of handled requests by transport and method",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"transport", "method"}),
+		Authentications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "authentications_total",
+			Help:      "Number of password authentications by result",
+		}, []string{"result"}),
+		IndexDocuments: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "index_documents",
+			Help:      "Number of indexed documents by type",
+		}, []string{"type"}),
+		ReindexDuration: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "reindex_duration_seconds",
+			Help:      "Duration of the last rebuild of the index",
+		}),
+		StorageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "storage_duration_seconds",
+			Help:      "Duration of storage operations by operation and record type",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"operation", "type"}),
+	}
+}
+
+// ObserveRequest records a handled request. The code is the http status code, also for grpc requests.
+func (m *Metrics) ObserveRequest(transport, method string, code int, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.Requests.WithLabelValues(transport, method, strconv.Itoa(code)).Inc()
+	m.RequestDuration.WithLabelValues(transport, method).Observe(time.Since(start).Seconds())
+}
+
+// ObserveAuthentication records the result of a password authentication
+func (m *Metrics) ObserveAuthentication(ok bool) {
+	if m == nil {
+		return
+	}
+	result := "failure"
+	if ok {
+		result = "success"
+	}
+	m.Authentications.WithLabelValues(result).Inc()
+}
+
+// SetIndexDocuments records the number of indexed documents of a type
+func (m *Metrics) SetIndexDocuments(documentType string, count uint64) {
+	if m == nil {
+		return
+	}
+	m.IndexDocuments.WithLabelValues(documentType).Set(float64(count))
+}
+
+// ObserveReindex records the duration of an index rebuild
+func (m *Metrics) ObserveReindex(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.ReindexDuration.Set(time.Since(start).Seconds())
+}
+
+// ObserveStorage records the duration of a storage operation, e.g. reading an account record
+func (m *Metrics) ObserveStorage(operation, recordType string, start time.Time) {
+	if m == nil {
+		return
 	}
-	// TODO: implement metrics
-	return m
+	m.StorageDuration.WithLabelValues(operation, recordType).Observe(time.Since(start).Seconds())
 }
@@ -0,0 +1,14 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRegistersOnce(t *testing.T) {
+	m := New()
+	assert.NotPanics(t, func() {
+		assert.Same(t, m, New())
+	})
+}
@@ -36,11 +36,25 @@ func mockAccSvc(retErr bool) proto.AccountsService {
 ```
 */
 type MockAccountsService struct {
-	ListFunc   func(ctx context.Context, in *ListAccountsRequest, opts ...client.CallOption) (*ListAccountsResponse, error)
-	GetFunc    func(ctx context.Context, in *GetAccountRequest, opts ...client.CallOption) (*Account, error)
-	CreateFunc func(ctx context.Context, in *CreateAccountRequest, opts ...client.CallOption) (*Account, error)
-	UpdateFunc func(ctx context.Context, in *UpdateAccountRequest, opts ...client.CallOption) (*Account, error)
-	DeleteFunc func(ctx context.Context, in *DeleteAccountRequest, opts ...client.CallOption) (*empty.Empty, error)
+	ListFunc            func(ctx context.Context, in *ListAccountsRequest, opts ...client.CallOption) (*ListAccountsResponse, error)
+	GetFunc             func(ctx context.Context, in *GetAccountRequest, opts ...client.CallOption) (*Account, error)
+	CreateFunc          func(ctx context.Context, in *CreateAccountRequest, opts ...client.CallOption) (*Account, error)
+	UpdateFunc          func(ctx context.Context, in *UpdateAccountRequest, opts ...client.CallOption) (*Account, error)
+	DeleteFunc          func(ctx context.Context, in *DeleteAccountRequest, opts ...client.CallOption) (*empty.Empty, error)
+	RestoreFunc         func(ctx context.Context, in *RestoreAccountRequest, opts ...client.CallOption) (*Account, error)
+	SyncFunc            func(ctx context.Context, in *SyncAccountsRequest, opts ...client.CallOption) (*SyncAccountsResponse, error)
+	AuditFunc           func(ctx context.Context, in *ListAuditRecordsRequest, opts ...client.CallOption) (*ListAuditRecordsResponse, error)
+	CreateKeyFunc       func(ctx context.Context, in *CreateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error)
+	RotateKeyFunc       func(ctx context.Context, in *RotateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error)
+	RevokeKeyFunc       func(ctx context.Context, in *RevokeApiKeyRequest, opts ...client.CallOption) (*empty.Empty, error)
+	AuthenticateKeyFunc func(ctx context.Context, in *AuthenticateApiKeyRequest, opts ...client.CallOption) (*Account, error)
+	RevokeSessionsFunc  func(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error)
+	ValidateSessionFunc func(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error)
+	ResolveIdentityFunc func(ctx context.Context, in *ResolveIdentityRequest, opts ...client.CallOption) (*Account, error)
+	LinkIdentityFunc    func(ctx context.Context, in *LinkIdentityRequest, opts ...client.CallOption) (*Account, error)
+	UnlinkIdentityFunc  func(ctx context.Context, in *UnlinkIdentityRequest, opts ...client.CallOption) (*Account, error)
+	InviteGuestFunc     func(ctx context.Context, in *InviteGuestRequest, opts ...client.CallOption) (*Invitation, error)
+	RedeemFunc          func(ctx context.Context, in *RedeemInvitationRequest, opts ...client.CallOption) (*Account, error)
 }
 
 // ListAccounts will panic if the function has been called, but not mocked
@@ -87,3 +101,129 @@ func (m MockAccountsService) DeleteAccount(ctx context.Context, in *DeleteAccoun
 
 	panic("DeleteFunc was called in test but not mocked")
 }
+
+// RestoreAccount will panic if the function has been called, but not mocked
+func (m MockAccountsService) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...client.CallOption) (*Account, error) {
+	if m.RestoreFunc != nil {
+		return m.RestoreFunc(ctx, in, opts...)
+	}
+
+	panic("RestoreFunc was called in test but not mocked")
+}
+
+// SyncAccounts will panic if the function has been called, but not mocked
+func (m MockAccountsService) SyncAccounts(ctx context.Context, in *SyncAccountsRequest, opts ...client.CallOption) (*SyncAccountsResponse, error) {
+	if m.SyncFunc != nil {
+		return m.SyncFunc(ctx, in, opts...)
+	}
+
+	panic("SyncFunc was called in test but not mocked")
+}
+
+// ListAuditRecords will panic if the function has been called, but not mocked
+func (m MockAccountsService) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...client.CallOption) (*ListAuditRecordsResponse, error) {
+	if m.AuditFunc != nil {
+		return m.AuditFunc(ctx, in, opts...)
+	}
+
+	panic("AuditFunc was called in test but not mocked")
+}
+
+// CreateApiKey will panic if the function has been called, but not mocked
+func (m MockAccountsService) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error) {
+	if m.CreateKeyFunc != nil {
+		return m.CreateKeyFunc(ctx, in, opts...)
+	}
+
+	panic("CreateKeyFunc was called in test but not mocked")
+}
+
+// RotateApiKey will panic if the function has been called, but not mocked
+func (m MockAccountsService) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error) {
+	if m.RotateKeyFunc != nil {
+		return m.RotateKeyFunc(ctx, in, opts...)
+	}
+
+	panic("RotateKeyFunc was called in test but not mocked")
+}
+
+// RevokeApiKey will panic if the function has been called, but not mocked
+func (m MockAccountsService) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...client.CallOption) (*empty.Empty, error) {
+	if m.RevokeKeyFunc != nil {
+		return m.RevokeKeyFunc(ctx, in, opts...)
+	}
+
+	panic("RevokeKeyFunc was called in test but not mocked")
+}
+
+// AuthenticateApiKey will panic if the function has been called, but not mocked
+func (m MockAccountsService) AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyRequest, opts ...client.CallOption) (*Account, error) {
+	if m.AuthenticateKeyFunc != nil {
+		return m.AuthenticateKeyFunc(ctx, in, opts...)
+	}
+
+	panic("AuthenticateKeyFunc was called in test but not mocked")
+}
+
+// RevokeSessions will panic if the function has been called, but not mocked
+func (m MockAccountsService) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error) {
+	if m.RevokeSessionsFunc != nil {
+		return m.RevokeSessionsFunc(ctx, in, opts...)
+	}
+
+	panic("RevokeSessionsFunc was called in test but not mocked")
+}
+
+// ValidateSession will panic if the function has been called, but not mocked
+func (m MockAccountsService) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error) {
+	if m.ValidateSessionFunc != nil {
+		return m.ValidateSessionFunc(ctx, in, opts...)
+	}
+
+	panic("ValidateSessionFunc was called in test but not mocked")
+}
+
+// ResolveIdentity will panic if the function has been called, but not mocked
+func (m MockAccountsService) ResolveIdentity(ctx context.Context, in *ResolveIdentityRequest, opts ...client.CallOption) (*Account, error) {
+	if m.ResolveIdentityFunc != nil {
+		return m.ResolveIdentityFunc(ctx, in, opts...)
+	}
+
+	panic("ResolveIdentityFunc was called in test but not mocked")
+}
+
+// LinkIdentity will panic if the function has been called, but not mocked
+func (m MockAccountsService) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...client.CallOption) (*Account, error) {
+	if m.LinkIdentityFunc != nil {
+		return m.LinkIdentityFunc(ctx, in, opts...)
+	}
+
+	panic("LinkIdentityFunc was called in test but not mocked")
+}
+
+// UnlinkIdentity will panic if the function has been called, but not mocked
+func (m MockAccountsService) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...client.CallOption) (*Account, error) {
+	if m.UnlinkIdentityFunc != nil {
+		return m.UnlinkIdentityFunc(ctx, in, opts...)
+	}
+
+	panic("UnlinkIdentityFunc was called in test but not mocked")
+}
+
+// InviteGuest will panic if the function has been called, but not mocked
+func (m MockAccountsService) InviteGuest(ctx context.Context, in *InviteGuestRequest, opts ...client.CallOption) (*Invitation, error) {
+	if m.InviteGuestFunc != nil {
+		return m.InviteGuestFunc(ctx, in, opts...)
+	}
+
+	panic("InviteGuestFunc was called in test but not mocked")
+}
+
+// RedeemInvitation will panic if the function has been called, but not mocked
+func (m MockAccountsService) RedeemInvitation(ctx context.Context, in *RedeemInvitationRequest, opts ...client.CallOption) (*Account, error) {
+	if m.RedeemFunc != nil {
+		return m.RedeemFunc(ctx, in, opts...)
+	}
+
+	panic("RedeemFunc was called in test but not mocked")
+}
@@ -76,6 +76,104 @@ func NewAccountsServiceEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "AccountsService.RestoreAccount",
+			Path:    []string{"/api/v0/accounts/accounts-restore"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.SyncAccounts",
+			Path:    []string{"/api/v0/accounts/accounts-sync"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ListAuditRecords",
+			Path:    []string{"/api/v0/accounts/audit-list"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RevokeSessions",
+			Path:    []string{"/api/v0/accounts/accounts-revoke-sessions"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ValidateSession",
+			Path:    []string{"/api/v0/accounts/sessions-validate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.InviteGuest",
+			Path:    []string{"/api/v0/accounts/invitations-create"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RedeemInvitation",
+			Path:    []string{"/api/v0/accounts/invitations-redeem"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ResolveIdentity",
+			Path:    []string{"/api/v0/accounts/identities-resolve"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.LinkIdentity",
+			Path:    []string{"/api/v0/accounts/identities-link"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.UnlinkIdentity",
+			Path:    []string{"/api/v0/accounts/identities-unlink"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.CreateApiKey",
+			Path:    []string{"/api/v0/accounts/api-keys-create"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RotateApiKey",
+			Path:    []string{"/api/v0/accounts/api-keys-rotate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RevokeApiKey",
+			Path:    []string{"/api/v0/accounts/api-keys-revoke"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.AuthenticateApiKey",
+			Path:    []string{"/api/v0/accounts/api-keys-authenticate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -92,6 +190,37 @@ type AccountsService interface {
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...client.CallOption) (*Account, error)
 	// Deletes an account
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...client.CallOption) (*empty.Empty, error)
+	// Restores a deleted account
+	RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...client.CallOption) (*Account, error)
+	// Imports accounts and groups from the configured LDAP directory
+	SyncAccounts(ctx context.Context, in *SyncAccountsRequest, opts ...client.CallOption) (*SyncAccountsResponse, error)
+	// Lists the audit records of account and group mutations
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...client.CallOption) (*ListAuditRecordsResponse, error)
+	// Invalidates the sessions and refresh tokens of an account, the account has to sign in again
+	// user:revokeSignInSessions https://docs.microsoft.com/en-us/graph/api/user-revokesigninsessions?view=graph-rest-1.0
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error)
+	// Checks if a token issued to an account at the given time is still valid
+	ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error)
+	// Invites an external user as a guest. The account is pending until the invitation is redeemed.
+	// invitation: create https://docs.microsoft.com/en-us/graph/api/invitation-post?view=graph-rest-1.0
+	InviteGuest(ctx context.Context, in *InviteGuestRequest, opts ...client.CallOption) (*Invitation, error)
+	// Redeems an invitation, sets the password of the guest and accepts the invitation
+	RedeemInvitation(ctx context.Context, in *RedeemInvitationRequest, opts ...client.CallOption) (*Account, error)
+	// Returns the account an external identity is linked to. Unknown identities get an account on the fly when
+	// just-in-time provisioning is enabled.
+	ResolveIdentity(ctx context.Context, in *ResolveIdentityRequest, opts ...client.CallOption) (*Account, error)
+	// Links an external identity to an account, an identity can only be linked to one account
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...client.CallOption) (*Account, error)
+	// Removes an external identity from an account
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...client.CallOption) (*Account, error)
+	// Creates an api key for a service account, the secret is only returned once
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error)
+	// Replaces the secret of an api key, the previous secret is no longer valid
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error)
+	// Revokes an api key of a service account
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...client.CallOption) (*empty.Empty, error)
+	// Returns the service account an api key belongs to, if it is valid
+	AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyRequest, opts ...client.CallOption) (*Account, error)
 }
 
 type accountsService struct {
@@ -156,6 +285,146 @@ func (c *accountsService) DeleteAccount(ctx context.Context, in *DeleteAccountRe
 	return out, nil
 }
 
+func (c *accountsService) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RestoreAccount", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) SyncAccounts(ctx context.Context, in *SyncAccountsRequest, opts ...client.CallOption) (*SyncAccountsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.SyncAccounts", in)
+	out := new(SyncAccountsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...client.CallOption) (*ListAuditRecordsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ListAuditRecords", in)
+	out := new(ListAuditRecordsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RevokeSessions", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ValidateSession", in)
+	out := new(ValidateSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) InviteGuest(ctx context.Context, in *InviteGuestRequest, opts ...client.CallOption) (*Invitation, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.InviteGuest", in)
+	out := new(Invitation)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RedeemInvitation(ctx context.Context, in *RedeemInvitationRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RedeemInvitation", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ResolveIdentity(ctx context.Context, in *ResolveIdentityRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ResolveIdentity", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.LinkIdentity", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.UnlinkIdentity", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.CreateApiKey", in)
+	out := new(ApiKeySecret)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...client.CallOption) (*ApiKeySecret, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RotateApiKey", in)
+	out := new(ApiKeySecret)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...client.CallOption) (*empty.Empty, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RevokeApiKey", in)
+	out := new(empty.Empty)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.AuthenticateApiKey", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AccountsService service
 
 type AccountsServiceHandler interface {
@@ -169,6 +438,37 @@ type AccountsServiceHandler interface {
 	UpdateAccount(context.Context, *UpdateAccountRequest, *Account) error
 	// Deletes an account
 	DeleteAccount(context.Context, *DeleteAccountRequest, *empty.Empty) error
+	// Restores a deleted account
+	RestoreAccount(context.Context, *RestoreAccountRequest, *Account) error
+	// Imports accounts and groups from the configured LDAP directory
+	SyncAccounts(context.Context, *SyncAccountsRequest, *SyncAccountsResponse) error
+	// Lists the audit records of account and group mutations
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest, *ListAuditRecordsResponse) error
+	// Invalidates the sessions and refresh tokens of an account, the account has to sign in again
+	// user:revokeSignInSessions https://docs.microsoft.com/en-us/graph/api/user-revokesigninsessions?view=graph-rest-1.0
+	RevokeSessions(context.Context, *RevokeSessionsRequest, *Account) error
+	// Checks if a token issued to an account at the given time is still valid
+	ValidateSession(context.Context, *ValidateSessionRequest, *ValidateSessionResponse) error
+	// Invites an external user as a guest. The account is pending until the invitation is redeemed.
+	// invitation: create https://docs.microsoft.com/en-us/graph/api/invitation-post?view=graph-rest-1.0
+	InviteGuest(context.Context, *InviteGuestRequest, *Invitation) error
+	// Redeems an invitation, sets the password of the guest and accepts the invitation
+	RedeemInvitation(context.Context, *RedeemInvitationRequest, *Account) error
+	// Returns the account an external identity is linked to. Unknown identities get an account on the fly when
+	// just-in-time provisioning is enabled.
+	ResolveIdentity(context.Context, *ResolveIdentityRequest, *Account) error
+	// Links an external identity to an account, an identity can only be linked to one account
+	LinkIdentity(context.Context, *LinkIdentityRequest, *Account) error
+	// Removes an external identity from an account
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest, *Account) error
+	// Creates an api key for a service account, the secret is only returned once
+	CreateApiKey(context.Context, *CreateApiKeyRequest, *ApiKeySecret) error
+	// Replaces the secret of an api key, the previous secret is no longer valid
+	RotateApiKey(context.Context, *RotateApiKeyRequest, *ApiKeySecret) error
+	// Revokes an api key of a service account
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest, *empty.Empty) error
+	// Returns the service account an api key belongs to, if it is valid
+	AuthenticateApiKey(context.Context, *AuthenticateApiKeyRequest, *Account) error
 }
 
 func RegisterAccountsServiceHandler(s server.Server, hdlr AccountsServiceHandler, opts ...server.HandlerOption) error {
@@ -178,6 +478,20 @@ func RegisterAccountsServiceHandler(s server.Server, hdlr AccountsServiceHandler
 		CreateAccount(ctx context.Context, in *CreateAccountRequest, out *Account) error
 		UpdateAccount(ctx context.Context, in *UpdateAccountRequest, out *Account) error
 		DeleteAccount(ctx context.Context, in *DeleteAccountRequest, out *empty.Empty) error
+		RestoreAccount(ctx context.Context, in *RestoreAccountRequest, out *Account) error
+		SyncAccounts(ctx context.Context, in *SyncAccountsRequest, out *SyncAccountsResponse) error
+		ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, out *ListAuditRecordsResponse) error
+		RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *Account) error
+		ValidateSession(ctx context.Context, in *ValidateSessionRequest, out *ValidateSessionResponse) error
+		InviteGuest(ctx context.Context, in *InviteGuestRequest, out *Invitation) error
+		RedeemInvitation(ctx context.Context, in *RedeemInvitationRequest, out *Account) error
+		ResolveIdentity(ctx context.Context, in *ResolveIdentityRequest, out *Account) error
+		LinkIdentity(ctx context.Context, in *LinkIdentityRequest, out *Account) error
+		UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, out *Account) error
+		CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, out *ApiKeySecret) error
+		RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, out *ApiKeySecret) error
+		RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, out *empty.Empty) error
+		AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyRequest, out *Account) error
 	}
 	type AccountsService struct {
 		accountsService
@@ -218,6 +532,104 @@ func RegisterAccountsServiceHandler(s server.Server, hdlr AccountsServiceHandler
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RestoreAccount",
+		Path:    []string{"/api/v0/accounts/accounts-restore"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.SyncAccounts",
+		Path:    []string{"/api/v0/accounts/accounts-sync"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ListAuditRecords",
+		Path:    []string{"/api/v0/accounts/audit-list"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RevokeSessions",
+		Path:    []string{"/api/v0/accounts/accounts-revoke-sessions"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ValidateSession",
+		Path:    []string{"/api/v0/accounts/sessions-validate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.InviteGuest",
+		Path:    []string{"/api/v0/accounts/invitations-create"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RedeemInvitation",
+		Path:    []string{"/api/v0/accounts/invitations-redeem"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ResolveIdentity",
+		Path:    []string{"/api/v0/accounts/identities-resolve"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.LinkIdentity",
+		Path:    []string{"/api/v0/accounts/identities-link"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.UnlinkIdentity",
+		Path:    []string{"/api/v0/accounts/identities-unlink"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.CreateApiKey",
+		Path:    []string{"/api/v0/accounts/api-keys-create"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RotateApiKey",
+		Path:    []string{"/api/v0/accounts/api-keys-rotate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RevokeApiKey",
+		Path:    []string{"/api/v0/accounts/api-keys-revoke"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.AuthenticateApiKey",
+		Path:    []string{"/api/v0/accounts/api-keys-authenticate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&AccountsService{h}, opts...))
 }
 
@@ -245,6 +657,62 @@ func (h *accountsServiceHandler) DeleteAccount(ctx context.Context, in *DeleteAc
 	return h.AccountsServiceHandler.DeleteAccount(ctx, in, out)
 }
 
+func (h *accountsServiceHandler) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, out *Account) error {
+	return h.AccountsServiceHandler.RestoreAccount(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) SyncAccounts(ctx context.Context, in *SyncAccountsRequest, out *SyncAccountsResponse) error {
+	return h.AccountsServiceHandler.SyncAccounts(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, out *ListAuditRecordsResponse) error {
+	return h.AccountsServiceHandler.ListAuditRecords(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *Account) error {
+	return h.AccountsServiceHandler.RevokeSessions(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ValidateSession(ctx context.Context, in *ValidateSessionRequest, out *ValidateSessionResponse) error {
+	return h.AccountsServiceHandler.ValidateSession(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) InviteGuest(ctx context.Context, in *InviteGuestRequest, out *Invitation) error {
+	return h.AccountsServiceHandler.InviteGuest(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RedeemInvitation(ctx context.Context, in *RedeemInvitationRequest, out *Account) error {
+	return h.AccountsServiceHandler.RedeemInvitation(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ResolveIdentity(ctx context.Context, in *ResolveIdentityRequest, out *Account) error {
+	return h.AccountsServiceHandler.ResolveIdentity(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, out *Account) error {
+	return h.AccountsServiceHandler.LinkIdentity(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, out *Account) error {
+	return h.AccountsServiceHandler.UnlinkIdentity(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, out *ApiKeySecret) error {
+	return h.AccountsServiceHandler.CreateApiKey(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, out *ApiKeySecret) error {
+	return h.AccountsServiceHandler.RotateApiKey(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, out *empty.Empty) error {
+	return h.AccountsServiceHandler.RevokeApiKey(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyRequest, out *Account) error {
+	return h.AccountsServiceHandler.AuthenticateApiKey(ctx, in, out)
+}
+
 // Api Endpoints for GroupsService service
 
 func NewGroupsServiceEndpoints() []*api.Endpoint {
@@ -284,6 +752,13 @@ func NewGroupsServiceEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "GroupsService.RestoreGroup",
+			Path:    []string{"/api/v0/accounts/groups-restore"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 		&api.Endpoint{
 			Name:    "GroupsService.AddMember",
 			Path:    []string{"/api/v0/groups/{group_id=*}/members/$ref"},
@@ -304,6 +779,33 @@ func NewGroupsServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "GroupsService.AddOwner",
+			Path:    []string{"/api/v0/groups/{group_id=*}/owners/$ref"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "GroupsService.RemoveOwner",
+			Path:    []string{"/api/v0/groups/{group_id=*}/owners/{account_id}/$ref"},
+			Method:  []string{"DELETE"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "GroupsService.ListOwners",
+			Path:    []string{"/api/v0/groups/{id=*}/owners/$ref"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "GroupsService.ListOwnedGroups",
+			Path:    []string{"/api/v0/accounts/groups-owned"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -320,12 +822,22 @@ type GroupsService interface {
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...client.CallOption) (*Group, error)
 	// Deletes a group
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...client.CallOption) (*empty.Empty, error)
+	// Restores a deleted group
+	RestoreGroup(ctx context.Context, in *RestoreGroupRequest, opts ...client.CallOption) (*Group, error)
 	// group:addmember https://docs.microsoft.com/en-us/graph/api/group-post-members?view=graph-rest-1.0&tabs=http
 	AddMember(ctx context.Context, in *AddMemberRequest, opts ...client.CallOption) (*Group, error)
 	// group:removemember https://docs.microsoft.com/en-us/graph/api/group-delete-members?view=graph-rest-1.0
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...client.CallOption) (*Group, error)
 	// group:listmembers https://docs.microsoft.com/en-us/graph/api/group-list-members?view=graph-rest-1.0
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...client.CallOption) (*ListMembersResponse, error)
+	// group:addowner https://docs.microsoft.com/en-us/graph/api/group-post-owners?view=graph-rest-1.0
+	AddOwner(ctx context.Context, in *AddOwnerRequest, opts ...client.CallOption) (*Group, error)
+	// group:removeowner https://docs.microsoft.com/en-us/graph/api/group-delete-owners?view=graph-rest-1.0
+	RemoveOwner(ctx context.Context, in *RemoveOwnerRequest, opts ...client.CallOption) (*Group, error)
+	// group:listowners https://docs.microsoft.com/en-us/graph/api/group-list-owners?view=graph-rest-1.0
+	ListOwners(ctx context.Context, in *ListOwnersRequest, opts ...client.CallOption) (*ListOwnersResponse, error)
+	// Lists the groups an account owns
+	ListOwnedGroups(ctx context.Context, in *ListOwnedGroupsRequest, opts ...client.CallOption) (*ListGroupsResponse, error)
 }
 
 type groupsService struct {
@@ -390,6 +902,16 @@ func (c *groupsService) DeleteGroup(ctx context.Context, in *DeleteGroupRequest,
 	return out, nil
 }
 
+func (c *groupsService) RestoreGroup(ctx context.Context, in *RestoreGroupRequest, opts ...client.CallOption) (*Group, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.RestoreGroup", in)
+	out := new(Group)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupsService) AddMember(ctx context.Context, in *AddMemberRequest, opts ...client.CallOption) (*Group, error) {
 	req := c.c.NewRequest(c.name, "GroupsService.AddMember", in)
 	out := new(Group)
@@ -420,6 +942,46 @@ func (c *groupsService) ListMembers(ctx context.Context, in *ListMembersRequest,
 	return out, nil
 }
 
+func (c *groupsService) AddOwner(ctx context.Context, in *AddOwnerRequest, opts ...client.CallOption) (*Group, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.AddOwner", in)
+	out := new(Group)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsService) RemoveOwner(ctx context.Context, in *RemoveOwnerRequest, opts ...client.CallOption) (*Group, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.RemoveOwner", in)
+	out := new(Group)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsService) ListOwners(ctx context.Context, in *ListOwnersRequest, opts ...client.CallOption) (*ListOwnersResponse, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.ListOwners", in)
+	out := new(ListOwnersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsService) ListOwnedGroups(ctx context.Context, in *ListOwnedGroupsRequest, opts ...client.CallOption) (*ListGroupsResponse, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.ListOwnedGroups", in)
+	out := new(ListGroupsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for GroupsService service
 
 type GroupsServiceHandler interface {
@@ -433,12 +995,22 @@ type GroupsServiceHandler interface {
 	UpdateGroup(context.Context, *UpdateGroupRequest, *Group) error
 	// Deletes a group
 	DeleteGroup(context.Context, *DeleteGroupRequest, *empty.Empty) error
+	// Restores a deleted group
+	RestoreGroup(context.Context, *RestoreGroupRequest, *Group) error
 	// group:addmember https://docs.microsoft.com/en-us/graph/api/group-post-members?view=graph-rest-1.0&tabs=http
 	AddMember(context.Context, *AddMemberRequest, *Group) error
 	// group:removemember https://docs.microsoft.com/en-us/graph/api/group-delete-members?view=graph-rest-1.0
 	RemoveMember(context.Context, *RemoveMemberRequest, *Group) error
 	// group:listmembers https://docs.microsoft.com/en-us/graph/api/group-list-members?view=graph-rest-1.0
 	ListMembers(context.Context, *ListMembersRequest, *ListMembersResponse) error
+	// group:addowner https://docs.microsoft.com/en-us/graph/api/group-post-owners?view=graph-rest-1.0
+	AddOwner(context.Context, *AddOwnerRequest, *Group) error
+	// group:removeowner https://docs.microsoft.com/en-us/graph/api/group-delete-owners?view=graph-rest-1.0
+	RemoveOwner(context.Context, *RemoveOwnerRequest, *Group) error
+	// group:listowners https://docs.microsoft.com/en-us/graph/api/group-list-owners?view=graph-rest-1.0
+	ListOwners(context.Context, *ListOwnersRequest, *ListOwnersResponse) error
+	// Lists the groups an account owns
+	ListOwnedGroups(context.Context, *ListOwnedGroupsRequest, *ListGroupsResponse) error
 }
 
 func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, opts ...server.HandlerOption) error {
@@ -448,9 +1020,14 @@ func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, op
 		CreateGroup(ctx context.Context, in *CreateGroupRequest, out *Group) error
 		UpdateGroup(ctx context.Context, in *UpdateGroupRequest, out *Group) error
 		DeleteGroup(ctx context.Context, in *DeleteGroupRequest, out *empty.Empty) error
+		RestoreGroup(ctx context.Context, in *RestoreGroupRequest, out *Group) error
 		AddMember(ctx context.Context, in *AddMemberRequest, out *Group) error
 		RemoveMember(ctx context.Context, in *RemoveMemberRequest, out *Group) error
 		ListMembers(ctx context.Context, in *ListMembersRequest, out *ListMembersResponse) error
+		AddOwner(ctx context.Context, in *AddOwnerRequest, out *Group) error
+		RemoveOwner(ctx context.Context, in *RemoveOwnerRequest, out *Group) error
+		ListOwners(ctx context.Context, in *ListOwnersRequest, out *ListOwnersResponse) error
+		ListOwnedGroups(ctx context.Context, in *ListOwnedGroupsRequest, out *ListGroupsResponse) error
 	}
 	type GroupsService struct {
 		groupsService
@@ -491,6 +1068,13 @@ func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, op
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.RestoreGroup",
+		Path:    []string{"/api/v0/accounts/groups-restore"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "GroupsService.AddMember",
 		Path:    []string{"/api/v0/groups/{group_id=*}/members/$ref"},
@@ -511,6 +1095,33 @@ func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, op
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.AddOwner",
+		Path:    []string{"/api/v0/groups/{group_id=*}/owners/$ref"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.RemoveOwner",
+		Path:    []string{"/api/v0/groups/{group_id=*}/owners/{account_id}/$ref"},
+		Method:  []string{"DELETE"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.ListOwners",
+		Path:    []string{"/api/v0/groups/{id=*}/owners/$ref"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.ListOwnedGroups",
+		Path:    []string{"/api/v0/accounts/groups-owned"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&GroupsService{h}, opts...))
 }
 
@@ -538,6 +1149,10 @@ func (h *groupsServiceHandler) DeleteGroup(ctx context.Context, in *DeleteGroupR
 	return h.GroupsServiceHandler.DeleteGroup(ctx, in, out)
 }
 
+func (h *groupsServiceHandler) RestoreGroup(ctx context.Context, in *RestoreGroupRequest, out *Group) error {
+	return h.GroupsServiceHandler.RestoreGroup(ctx, in, out)
+}
+
 func (h *groupsServiceHandler) AddMember(ctx context.Context, in *AddMemberRequest, out *Group) error {
 	return h.GroupsServiceHandler.AddMember(ctx, in, out)
 }
@@ -549,3 +1164,19 @@ func (h *groupsServiceHandler) RemoveMember(ctx context.Context, in *RemoveMembe
 func (h *groupsServiceHandler) ListMembers(ctx context.Context, in *ListMembersRequest, out *ListMembersResponse) error {
 	return h.GroupsServiceHandler.ListMembers(ctx, in, out)
 }
+
+func (h *groupsServiceHandler) AddOwner(ctx context.Context, in *AddOwnerRequest, out *Group) error {
+	return h.GroupsServiceHandler.AddOwner(ctx, in, out)
+}
+
+func (h *groupsServiceHandler) RemoveOwner(ctx context.Context, in *RemoveOwnerRequest, out *Group) error {
+	return h.GroupsServiceHandler.RemoveOwner(ctx, in, out)
+}
+
+func (h *groupsServiceHandler) ListOwners(ctx context.Context, in *ListOwnersRequest, out *ListOwnersResponse) error {
+	return h.GroupsServiceHandler.ListOwners(ctx, in, out)
+}
+
+func (h *groupsServiceHandler) ListOwnedGroups(ctx context.Context, in *ListOwnedGroupsRequest, out *ListGroupsResponse) error {
+	return h.GroupsServiceHandler.ListOwnedGroups(ctx, in, out)
+}
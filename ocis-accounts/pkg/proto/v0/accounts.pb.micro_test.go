@@ -8,12 +8,15 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/micro/go-micro/v2/client"
 	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/server"
 	"github.com/owncloud/ocis-pkg/v2/service/grpc"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/command"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
@@ -27,6 +30,8 @@ var service = grpc.Service{}
 
 const dataPath = "./accounts-store"
 
+const jwtSecret = "Pive-Fumkiu4"
+
 var newCreatedAccounts = []string{}
 var newCreatedGroups = []string{}
 
@@ -61,7 +66,7 @@ func getAccount(user string) *proto.Account {
 			DisplayName:              "User Two",
 			PreferredName:            "user2",
 			OnPremisesSamAccountName: "user2",
-			UidNumber:                20009,
+			UidNumber:                20008, // user1 used to share 20009, see TestCreateAccountDuplicateUidNumber
 			GidNumber:                30000,
 			Mail:                     "user2@example.com",
 			Identities:               []*proto.Identities{nil},
@@ -163,9 +168,18 @@ func init() {
 
 	cfg := config.New()
 	cfg.Server.AccountsDataPath = dataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix.UID = config.Bound{Lower: 20000, Upper: 29999}
+	cfg.Posix.GID = config.Bound{Lower: 30000, Upper: 39999}
+	cfg.Posix.DefaultGID = 30000
+	cfg.TokenManager.JWTSecret = jwtSecret
 	var hdlr *svc.Service
 	var err error
 
+	if err = service.Server().Init(server.WrapHandler(auth.HandlerWrapper(jwtSecret, false))); err != nil {
+		log.Fatal(err)
+	}
+
 	if hdlr, err = svc.New(svc.Logger(command.NewLogger(cfg)), svc.Config(cfg), svc.RoleService(buildRoleServiceMock())); err != nil {
 		log.Fatalf("Could not create new service")
 	}
@@ -185,6 +199,11 @@ func init() {
 	}
 }
 
+// newClient returns a client that authenticates as a service
+func newClient() client.Client {
+	return auth.ClientWrapper(jwtSecret, "com.owncloud.api.test")(service.Client())
+}
+
 func buildRoleServiceMock() settings.RoleService {
 	return settings.MockRoleService{
 		AssignRoleToUserFunc: func(ctx context.Context, req *settings.AssignRoleToUserRequest, opts ...client.CallOption) (res *settings.AssignRoleToUserResponse, err error) {
@@ -315,7 +334,7 @@ func checkError(t *testing.T, err error) {
 }
 
 func createAccount(t *testing.T, user string) (*proto.Account, error) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
 
 	account := getAccount(user)
@@ -328,7 +347,7 @@ func createAccount(t *testing.T, user string) (*proto.Account, error) {
 }
 
 func createGroup(t *testing.T, group *proto.Group) (*proto.Group, error) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	request := &proto.CreateGroupRequest{Group: group}
@@ -340,7 +359,7 @@ func createGroup(t *testing.T, group *proto.Group) (*proto.Group, error) {
 }
 
 func updateAccount(t *testing.T, account *proto.Account, updateArray []string) (*proto.Account, error) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
 
 	updateMask := &field_mask.FieldMask{
@@ -354,7 +373,7 @@ func updateAccount(t *testing.T, account *proto.Account, updateArray []string) (
 
 func listAccounts(t *testing.T) (*proto.ListAccountsResponse, error) {
 	request := &proto.ListAccountsRequest{}
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
 
 	response, err := cl.ListAccounts(context.Background(), request)
@@ -363,7 +382,7 @@ func listAccounts(t *testing.T) (*proto.ListAccountsResponse, error) {
 
 func listGroups(t *testing.T) *proto.ListGroupsResponse {
 	request := &proto.ListGroupsRequest{}
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	response, err := cl.ListGroups(context.Background(), request)
@@ -372,7 +391,7 @@ func listGroups(t *testing.T) *proto.ListGroupsResponse {
 }
 
 func deleteAccount(t *testing.T, id string) (*empty.Empty, error) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
 
 	req := &proto.DeleteAccountRequest{Id: id}
@@ -381,7 +400,7 @@ func deleteAccount(t *testing.T, id string) (*empty.Empty, error) {
 }
 
 func deleteGroup(t *testing.T, id string) (*empty.Empty, error) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.DeleteGroupRequest{Id: id}
@@ -396,15 +415,17 @@ func TestCreateAccount(t *testing.T) {
 	checkError(t, err)
 	assertUserExists(t, getAccount("user1"))
 	assert.IsType(t, &proto.Account{}, resp)
-	// Account is not returned in response
-	// assertAccountsSame(t, getAccount("user1"), resp)
+	assertAccountsSame(t, getAccount("user1"), resp)
+	assert.NotNil(t, resp.CreatedDateTime)
+	assert.NotNil(t, resp.LastModifiedDateTime)
 
 	resp, err = createAccount(t, "user2")
 	checkError(t, err)
 	assertUserExists(t, getAccount("user2"))
 	assert.IsType(t, &proto.Account{}, resp)
-	// Account is not returned in response
-	// assertAccountsSame(t, getAccount("user2"), resp)
+	assertAccountsSame(t, getAccount("user2"), resp)
+	assert.NotNil(t, resp.CreatedDateTime)
+	assert.NotNil(t, resp.LastModifiedDateTime)
 
 	cleanUp(t)
 }
@@ -423,6 +444,155 @@ func TestCreateExistingUser(t *testing.T) {
 
 // All tests fail after running this
 // https://github.com/refs/ocis-mono/ocis-accounts/issues/62
+func TestCreateAccountAllocatesUidNumber(t *testing.T) {
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+
+	account := getAccount("user3")
+	account.UidNumber = 0
+	resp, err := cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	checkError(t, err)
+	newCreatedAccounts = append(newCreatedAccounts, account.Id)
+
+	assert.GreaterOrEqual(t, resp.UidNumber, int64(20000))
+	assert.LessOrEqual(t, resp.UidNumber, int64(29999))
+	assert.Equal(t, int64(30000), resp.GidNumber)
+
+	// the number is taken now
+	account = getAccount("user4")
+	account.UidNumber = resp.UidNumber
+	_, err = cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	cleanUp(t)
+}
+
+func TestCreateAccountDuplicateUidNumber(t *testing.T) {
+	_, err := createAccount(t, "user1")
+	checkError(t, err)
+
+	// user2 with the uid number of user1, as both were defined before uid numbers had to be unique
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+	account := getAccount("user2")
+	account.UidNumber = 20009
+	_, err = cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	cleanUp(t)
+}
+
+func TestCreateGroupAllocatesGidNumber(t *testing.T) {
+	group := &proto.Group{Id: "4a8e2b7c-0f6d-4b8e-9a61-5c3f2f1e7d90", OnPremisesSamAccountName: "gidless-group", DisplayName: "Gidless Group"}
+
+	res, err := createGroup(t, group)
+	checkError(t, err)
+	assert.GreaterOrEqual(t, res.GidNumber, int64(30000))
+	assert.LessOrEqual(t, res.GidNumber, int64(39999))
+
+	// the gid of the users group is taken
+	_, err = createGroup(t, &proto.Group{Id: "6b1d3c5e-7f9a-4b2c-8d4e-0a1b2c3d4e5f", GidNumber: 30000, OnPremisesSamAccountName: "duplicate-gid", DisplayName: "Duplicate gid"})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	cleanUp(t)
+}
+
+func TestCreateAccountUniqueProperties(t *testing.T) {
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+
+	account := getAccount("user3")
+	account.UidNumber = 0
+	_, err := cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	checkError(t, err)
+	newCreatedAccounts = append(newCreatedAccounts, account.Id)
+
+	// names and mail addresses are compared case insensitive
+	account = getAccount("user4")
+	account.UidNumber = 0
+	account.PreferredName = "User3"
+	_, err = cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	account = getAccount("user4")
+	account.UidNumber = 0
+	account.Mail = "USER3@example.com"
+	_, err = cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	account = getAccount("user4")
+	account.UidNumber = 0
+	_, err = cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	checkError(t, err)
+	newCreatedAccounts = append(newCreatedAccounts, account.Id)
+
+	account.Mail = "user3@example.com"
+	_, err = updateAccount(t, account, []string{"Mail"})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	cleanUp(t)
+}
+
+func TestCreateGroupUniqueSamAccountName(t *testing.T) {
+	_, err := createGroup(t, &proto.Group{Id: "9c2e4f6a-1b3d-4e5f-8a7b-2c4d6e8f0a1b", OnPremisesSamAccountName: "Users", DisplayName: "Duplicate users"})
+	assert.Error(t, err)
+	assert.EqualValues(t, 409, merrors.FromError(err).Code)
+
+	cleanUp(t)
+}
+
+func TestCreateAccountResolvesGroupNames(t *testing.T) {
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+	gcl := proto.NewGroupsService("com.owncloud.api.accounts", client)
+
+	account := getAccount("user3")
+	account.UidNumber = 0
+	account.MemberOf = []*proto.Group{
+		{OnPremisesSamAccountName: "Sailing-Lovers"},
+		{Id: getGroup("users").Id},
+	}
+	resp, err := cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	checkError(t, err)
+	newCreatedAccounts = append(newCreatedAccounts, account.Id)
+
+	assert.Len(t, resp.MemberOf, 2)
+	for _, g := range []string{"sailing-lovers", "users"} {
+		grp, err := gcl.GetGroup(context.Background(), &proto.GetGroupRequest{Id: getGroup(g).Id})
+		checkError(t, err)
+		assertGroupHasMember(t, grp, account.Id)
+	}
+
+	// replace the memberships
+	account.MemberOf = []*proto.Group{{OnPremisesSamAccountName: "violin-haters"}}
+	resp, err = updateAccount(t, account, []string{"MemberOf"})
+	checkError(t, err)
+	assert.Len(t, resp.MemberOf, 1)
+	assert.Equal(t, getGroup("violin-haters").Id, resp.MemberOf[0].Id)
+
+	grp, err := gcl.GetGroup(context.Background(), &proto.GetGroupRequest{Id: getGroup("sailing-lovers").Id})
+	checkError(t, err)
+	for _, m := range grp.Members {
+		assert.NotEqual(t, account.Id, m.Id)
+	}
+
+	// unknown groups are rejected
+	account = getAccount("user4")
+	account.UidNumber = 0
+	account.MemberOf = []*proto.Group{{OnPremisesSamAccountName: "no-such-group"}}
+	_, err = cl.CreateAccount(context.Background(), &proto.CreateAccountRequest{Account: account})
+	assert.Error(t, err)
+	assert.EqualValues(t, 400, merrors.FromError(err).Code)
+
+	cleanUp(t)
+}
+
 func TestCreateAccountInvalidUserName(t *testing.T) {
 
 	resp, err := listAccounts(t)
@@ -611,7 +781,7 @@ func TestListAccounts(t *testing.T) {
 	checkError(t, err)
 
 	assert.IsType(t, &proto.ListAccountsResponse{}, resp)
-	assert.Equal(t, 8, len(resp.Accounts))
+	assert.Equal(t, 6, len(resp.Accounts))
 
 	assertResponseContainsUser(t, resp, getAccount("user1"))
 	assertResponseContainsUser(t, resp, getAccount("user2"))
@@ -619,13 +789,76 @@ func TestListAccounts(t *testing.T) {
 	cleanUp(t)
 }
 
+func TestListAccountsByLifecycleTimestamps(t *testing.T) {
+	createAccount(t, "user1")
+	user1 := getAccount("user1")
+
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	resp, err := cl.ListAccounts(context.Background(), &proto.ListAccountsRequest{
+		Query: fmt.Sprintf("created_date_time ge %s", hourAgo),
+	})
+	checkError(t, err)
+	assertResponseContainsUser(t, resp, user1)
+
+	resp, err = cl.ListAccounts(context.Background(), &proto.ListAccountsRequest{
+		Query: fmt.Sprintf("created_date_time lt %s", hourAgo),
+	})
+	checkError(t, err)
+	assertResponseNotContainsUser(t, resp, user1)
+
+	// signing in updates the last sign-in
+	_, err = cl.ListAccounts(context.Background(), &proto.ListAccountsRequest{
+		Query: fmt.Sprintf("login eq '%s' and password eq '%s'", user1.OnPremisesSamAccountName, user1.PasswordProfile.Password),
+	})
+	checkError(t, err)
+
+	acc, err := cl.GetAccount(context.Background(), &proto.GetAccountRequest{Id: user1.Id})
+	checkError(t, err)
+	assert.NotNil(t, acc.LastSignInDateTime)
+
+	resp, err = cl.ListAccounts(context.Background(), &proto.ListAccountsRequest{
+		Query: fmt.Sprintf("last_sign_in_date_time ge %s", hourAgo),
+	})
+	checkError(t, err)
+	assertResponseContainsUser(t, resp, user1)
+
+	cleanUp(t)
+}
+
 func TestListWithoutUserCreation(t *testing.T) {
 	resp, err := listAccounts(t)
 
 	checkError(t, err)
 
-	// Only 5 default users
-	assert.Equal(t, 6, len(resp.Accounts))
+	// Only 4 default users, the service accounts konnectd and reva are not listed
+	assert.Equal(t, 4, len(resp.Accounts))
+	cleanUp(t)
+}
+
+func TestListAccountsShowServiceAccounts(t *testing.T) {
+	createAccount(t, "user1")
+
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+
+	resp, err := cl.ListAccounts(context.Background(), &proto.ListAccountsRequest{ShowServiceAccounts: true})
+	checkError(t, err)
+
+	// the default users and user1, including the service accounts konnectd and reva
+	assert.Equal(t, 7, len(resp.Accounts))
+	assertResponseContainsUser(t, resp, getAccount("user1"))
+	var serviceAccounts []string
+	for _, a := range resp.Accounts {
+		if a.IsServiceAccount {
+			serviceAccounts = append(serviceAccounts, a.OnPremisesSamAccountName)
+		}
+	}
+	assert.ElementsMatch(t, []string{"konnectd", "reva"}, serviceAccounts)
+
 	cleanUp(t)
 }
 
@@ -634,7 +867,7 @@ func TestGetAccount(t *testing.T) {
 
 	req := &proto.GetAccountRequest{Id: getAccount("user1").Id}
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
 
 	resp, err := cl.GetAccount(context.Background(), req)
@@ -652,7 +885,7 @@ func TestDeleteAccount(t *testing.T) {
 
 	req := &proto.DeleteAccountRequest{Id: getAccount("user1").Id}
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
 
 	resp, err := cl.DeleteAccount(context.Background(), req)
@@ -667,10 +900,95 @@ func TestDeleteAccount(t *testing.T) {
 	cleanUp(t)
 }
 
+func TestRestoreAccount(t *testing.T) {
+	grp1 := getTestGroups("grp1")
+	account := getAccount("user1")
+
+	createGroup(t, grp1)
+	createAccount(t, account.PreferredName)
+	addMemberToGroup(t, grp1.Id, account.Id)
+
+	_, err := deleteAccount(t, account.Id)
+	checkError(t, err)
+
+	client := newClient()
+	cl := proto.NewAccountsService("com.owncloud.api.accounts", client)
+
+	// deleted accounts are only listed on request
+	accountList, err := listAccounts(t)
+	checkError(t, err)
+	assertResponseNotContainsUser(t, accountList, account)
+
+	accountList, err = cl.ListAccounts(context.Background(), &proto.ListAccountsRequest{
+		Query:       "preferred_name eq 'user1'",
+		ShowDeleted: true,
+	})
+	checkError(t, err)
+	assertResponseContainsUser(t, accountList, account)
+
+	deleted, err := cl.GetAccount(context.Background(), &proto.GetAccountRequest{Id: account.Id})
+	checkError(t, err)
+	assert.NotNil(t, deleted.DeletedDateTime)
+	assert.False(t, deleted.AccountEnabled)
+
+	resp, err := cl.RestoreAccount(context.Background(), &proto.RestoreAccountRequest{Id: account.Id})
+	checkError(t, err)
+	assert.Nil(t, resp.DeletedDateTime)
+	assert.True(t, resp.AccountEnabled)
+
+	accountList, err = listAccounts(t)
+	checkError(t, err)
+	assertResponseContainsUser(t, accountList, account)
+
+	// the group membership is restored
+	groupsResponse := listGroups(t)
+	for _, g := range groupsResponse.Groups {
+		if g.Id == grp1.Id {
+			assertGroupHasMember(t, g, account.Id)
+		}
+	}
+
+	// only deleted accounts can be restored
+	_, err = cl.RestoreAccount(context.Background(), &proto.RestoreAccountRequest{Id: account.Id})
+	assert.Error(t, err)
+
+	cleanUp(t)
+}
+
+func TestRestoreGroup(t *testing.T) {
+	grp3 := getTestGroups("grp3")
+	createGroup(t, grp3)
+
+	_, err := deleteGroup(t, grp3.Id)
+	checkError(t, err)
+
+	client := newClient()
+	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
+
+	groupsResponse := listGroups(t)
+	assertResponseNotContainsGroup(t, groupsResponse, grp3)
+
+	groupsResponse, err = cl.ListGroups(context.Background(), &proto.ListGroupsRequest{
+		Query:       "on_premises_sam_account_name eq 'grp3'",
+		ShowDeleted: true,
+	})
+	checkError(t, err)
+	assertResponseContainsGroup(t, groupsResponse, grp3)
+
+	resp, err := cl.RestoreGroup(context.Background(), &proto.RestoreGroupRequest{Id: grp3.Id})
+	checkError(t, err)
+	assert.Nil(t, resp.DeletedDateTime)
+
+	groupsResponse = listGroups(t)
+	assertResponseContainsGroup(t, groupsResponse, grp3)
+
+	cleanUp(t)
+}
+
 func TestListGroups(t *testing.T) {
 	req := &proto.ListGroupsRequest{}
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	resp, err := cl.ListGroups(context.Background(), req)
@@ -697,7 +1015,7 @@ func TestListGroups(t *testing.T) {
 }
 
 func TestGetGroups(t *testing.T) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	groups := []string{
@@ -735,9 +1053,7 @@ func TestCreateGroup(t *testing.T) {
 	checkError(t, err)
 
 	assert.IsType(t, &proto.Group{}, res)
-
-	// Should return the group but does not
-	// assertGroupsSame(t, res, group)
+	assertGroupsSame(t, res, group)
 
 	groupsResponse := listGroups(t)
 	assertResponseContainsGroup(t, groupsResponse, group)
@@ -745,7 +1061,7 @@ func TestCreateGroup(t *testing.T) {
 }
 
 func TestGetGroupInvalidID(t *testing.T) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.GetGroupRequest{Id: "42"}
@@ -766,7 +1082,7 @@ func TestDeleteGroup(t *testing.T) {
 	createGroup(t, grp2)
 	createGroup(t, grp3)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.DeleteGroupRequest{Id: grp1.Id}
@@ -795,7 +1111,7 @@ func TestDeleteGroupNotExisting(t *testing.T) {
 		"  ",
 	}
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	for _, id := range invalidIds {
@@ -823,7 +1139,7 @@ func TestDeleteGroupInvalidId(t *testing.T) {
 		"":                                      ".",
 	}
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	for id, val := range invalidIds {
@@ -845,7 +1161,7 @@ func TestUpdateGroup(t *testing.T) {
 	grp1 := getTestGroups("grp1")
 	createGroup(t, grp1)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	updateGrp := &proto.Group{
@@ -878,7 +1194,7 @@ func TestAddMember(t *testing.T) {
 	createGroup(t, grp1)
 	createAccount(t, account.PreferredName)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.AddMemberRequest{GroupId: grp1.Id, AccountId: account.Id}
@@ -910,7 +1226,7 @@ func TestAddMemberAlreadyInGroup(t *testing.T) {
 
 	addMemberToGroup(t, grp1.Id, account.Id)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.AddMemberRequest{GroupId: grp1.Id, AccountId: account.Id}
@@ -935,7 +1251,7 @@ func TestAddMemberNonExisting(t *testing.T) {
 
 	createGroup(t, grp1)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	invalidIds := []string{
@@ -968,7 +1284,7 @@ func TestAddMemberNonExisting(t *testing.T) {
 }
 
 func addMemberToGroup(t *testing.T, groupId, memberId string) (*proto.Group, error) {
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.AddMemberRequest{GroupId: groupId, AccountId: memberId}
@@ -988,7 +1304,7 @@ func TestRemoveMember(t *testing.T) {
 
 	addMemberToGroup(t, grp1.Id, account.Id)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.RemoveMemberRequest{GroupId: grp1.Id, AccountId: account.Id}
@@ -1011,7 +1327,7 @@ func TestRemoveMemberNonExistingUser(t *testing.T) {
 
 	createGroup(t, grp1)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	invalidIds := []string{
@@ -1050,7 +1366,7 @@ func TestRemoveMemberNotInGroup(t *testing.T) {
 	createGroup(t, grp1)
 	createAccount(t, account.PreferredName)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.RemoveMemberRequest{GroupId: grp1.Id, AccountId: account.Id}
@@ -1087,7 +1403,7 @@ func TestListMembers(t *testing.T) {
 		"physics-lovers",
 	}
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	for _, group := range groups {
@@ -1121,7 +1437,7 @@ func TestListMembersEmptyGroup(t *testing.T) {
 
 	createGroup(t, group)
 
-	client := service.Client()
+	client := newClient()
 	cl := proto.NewGroupsService("com.owncloud.api.accounts", client)
 
 	req := &proto.ListMembersRequest{Id: group.Id}
@@ -1137,7 +1453,7 @@ func TestListMembersEmptyGroup(t *testing.T) {
 func TestAccountUpdateMask(t *testing.T) {
 	createAccount(t, "user1")
 	user1 := getAccount("user1")
-	client := service.Client()
+	client := newClient()
 	req := &proto.UpdateAccountRequest{
 		// We only want to update the display-name, rest should be ignored
 		UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName"}},
@@ -1160,7 +1476,7 @@ func TestAccountUpdateMask(t *testing.T) {
 func TestAccountUpdateReadOnlyField(t *testing.T) {
 	createAccount(t, "user1")
 	user1 := getAccount("user1")
-	client := service.Client()
+	client := newClient()
 	req := &proto.UpdateAccountRequest{
 		// We only want to update the display-name, rest should be ignored
 		UpdateMask: &field_mask.FieldMask{Paths: []string{"CreatedDateTime"}},
@@ -1185,3 +1501,17 @@ func TestAccountUpdateReadOnlyField(t *testing.T) {
 
 	cleanUp(t)
 }
+
+func TestUnauthenticatedCalls(t *testing.T) {
+	unauthenticated := proto.NewAccountsService("com.owncloud.api.accounts", service.Client())
+	_, err := unauthenticated.ListAccounts(context.Background(), &proto.ListAccountsRequest{})
+	assert.EqualValues(t, 401, merrors.FromError(err).Code)
+
+	groups := proto.NewGroupsService("com.owncloud.api.accounts", service.Client())
+	_, err = groups.ListGroups(context.Background(), &proto.ListGroupsRequest{})
+	assert.EqualValues(t, 401, merrors.FromError(err).Code)
+
+	forged := proto.NewAccountsService("com.owncloud.api.accounts", auth.ClientWrapper("wrong", "com.owncloud.api.test")(service.Client()))
+	_, err = forged.ListAccounts(context.Background(), &proto.ListAccountsRequest{})
+	assert.EqualValues(t, 401, merrors.FromError(err).Code)
+}
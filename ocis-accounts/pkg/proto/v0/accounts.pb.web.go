@@ -143,40 +143,90 @@ func (h *webAccountsServiceHandler) DeleteAccount(w http.ResponseWriter, r *http
 	render.NoContent(w, r)
 }
 
-func RegisterAccountsServiceWeb(r chi.Router, i AccountsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webAccountsServiceHandler{
-		r: r,
-		h: i,
+func (h *webAccountsServiceHandler) RestoreAccount(w http.ResponseWriter, r *http.Request) {
+
+	req := &RestoreAccountRequest{}
+
+	resp := &Account{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-list", handler.ListAccounts)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-get", handler.GetAccount)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-create", handler.CreateAccount)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-update", handler.UpdateAccount)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-delete", handler.DeleteAccount)
+	if err := h.h.RestoreAccount(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-type webGroupsServiceHandler struct {
-	r chi.Router
-	h GroupsServiceHandler
+func (h *webAccountsServiceHandler) SyncAccounts(w http.ResponseWriter, r *http.Request) {
+
+	req := &SyncAccountsRequest{}
+
+	resp := &SyncAccountsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.SyncAccounts(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	h.r.ServeHTTP(w, r)
+func (h *webAccountsServiceHandler) ListAuditRecords(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListAuditRecordsRequest{}
+
+	resp := &ListAuditRecordsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListAuditRecords(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) RevokeSessions(w http.ResponseWriter, r *http.Request) {
 
-	req := &ListGroupsRequest{}
+	req := &RevokeSessionsRequest{}
 
-	resp := &ListGroupsResponse{}
+	resp := &Account{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListGroups(
+	if err := h.h.RevokeSessions(
 		r.Context(),
 		req,
 		resp,
@@ -189,18 +239,18 @@ func (h *webGroupsServiceHandler) ListGroups(w http.ResponseWriter, r *http.Requ
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) ValidateSession(w http.ResponseWriter, r *http.Request) {
 
-	req := &GetGroupRequest{}
+	req := &ValidateSessionRequest{}
 
-	resp := &Group{}
+	resp := &ValidateSessionResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.GetGroup(
+	if err := h.h.ValidateSession(
 		r.Context(),
 		req,
 		resp,
@@ -213,18 +263,18 @@ func (h *webGroupsServiceHandler) GetGroup(w http.ResponseWriter, r *http.Reques
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) InviteGuest(w http.ResponseWriter, r *http.Request) {
 
-	req := &CreateGroupRequest{}
+	req := &InviteGuestRequest{}
 
-	resp := &Group{}
+	resp := &Invitation{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.CreateGroup(
+	if err := h.h.InviteGuest(
 		r.Context(),
 		req,
 		resp,
@@ -237,18 +287,18 @@ func (h *webGroupsServiceHandler) CreateGroup(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) RedeemInvitation(w http.ResponseWriter, r *http.Request) {
 
-	req := &UpdateGroupRequest{}
+	req := &RedeemInvitationRequest{}
 
-	resp := &Group{}
+	resp := &Account{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.UpdateGroup(
+	if err := h.h.RedeemInvitation(
 		r.Context(),
 		req,
 		resp,
@@ -261,9 +311,129 @@ func (h *webGroupsServiceHandler) UpdateGroup(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) ResolveIdentity(w http.ResponseWriter, r *http.Request) {
 
-	req := &DeleteGroupRequest{}
+	req := &ResolveIdentityRequest{}
+
+	resp := &Account{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ResolveIdentity(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) LinkIdentity(w http.ResponseWriter, r *http.Request) {
+
+	req := &LinkIdentityRequest{}
+
+	resp := &Account{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.LinkIdentity(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+
+	req := &UnlinkIdentityRequest{}
+
+	resp := &Account{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.UnlinkIdentity(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	req := &CreateApiKeyRequest{}
+
+	resp := &ApiKeySecret{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CreateApiKey(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) RotateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	req := &RotateApiKeyRequest{}
+
+	resp := &ApiKeySecret{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RotateApiKey(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+
+	req := &RevokeApiKeyRequest{}
 	resp := &empty.Empty{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -271,7 +441,7 @@ func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := h.h.DeleteGroup(
+	if err := h.h.RevokeApiKey(
 		r.Context(),
 		req,
 		resp,
@@ -284,18 +454,18 @@ func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Req
 	render.NoContent(w, r)
 }
 
-func (h *webGroupsServiceHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) AuthenticateApiKey(w http.ResponseWriter, r *http.Request) {
 
-	req := &AddMemberRequest{}
+	req := &AuthenticateApiKeyRequest{}
 
-	resp := &Group{}
+	resp := &Account{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.AddMember(
+	if err := h.h.AuthenticateApiKey(
 		r.Context(),
 		req,
 		resp,
@@ -308,18 +478,54 @@ func (h *webGroupsServiceHandler) AddMember(w http.ResponseWriter, r *http.Reque
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+func RegisterAccountsServiceWeb(r chi.Router, i AccountsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webAccountsServiceHandler{
+		r: r,
+		h: i,
+	}
 
-	req := &RemoveMemberRequest{}
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-list", handler.ListAccounts)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-get", handler.GetAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-create", handler.CreateAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-update", handler.UpdateAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-delete", handler.DeleteAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-restore", handler.RestoreAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-sync", handler.SyncAccounts)
+	r.MethodFunc("POST", "/api/v0/accounts/audit-list", handler.ListAuditRecords)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-revoke-sessions", handler.RevokeSessions)
+	r.MethodFunc("POST", "/api/v0/accounts/sessions-validate", handler.ValidateSession)
+	r.MethodFunc("POST", "/api/v0/accounts/invitations-create", handler.InviteGuest)
+	r.MethodFunc("POST", "/api/v0/accounts/invitations-redeem", handler.RedeemInvitation)
+	r.MethodFunc("POST", "/api/v0/accounts/identities-resolve", handler.ResolveIdentity)
+	r.MethodFunc("POST", "/api/v0/accounts/identities-link", handler.LinkIdentity)
+	r.MethodFunc("POST", "/api/v0/accounts/identities-unlink", handler.UnlinkIdentity)
+	r.MethodFunc("POST", "/api/v0/accounts/api-keys-create", handler.CreateApiKey)
+	r.MethodFunc("POST", "/api/v0/accounts/api-keys-rotate", handler.RotateApiKey)
+	r.MethodFunc("POST", "/api/v0/accounts/api-keys-revoke", handler.RevokeApiKey)
+	r.MethodFunc("POST", "/api/v0/accounts/api-keys-authenticate", handler.AuthenticateApiKey)
+}
 
-	resp := &Group{}
+type webGroupsServiceHandler struct {
+	r chi.Router
+	h GroupsServiceHandler
+}
+
+func (h *webGroupsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.r.ServeHTTP(w, r)
+}
+
+func (h *webGroupsServiceHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListGroupsRequest{}
+
+	resp := &ListGroupsResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.RemoveMember(
+	if err := h.h.ListGroups(
 		r.Context(),
 		req,
 		resp,
@@ -328,22 +534,22 @@ func (h *webGroupsServiceHandler) RemoveMember(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	render.Status(r, http.StatusOK)
+	render.Status(r, http.StatusCreated)
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+func (h *webGroupsServiceHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
 
-	req := &ListMembersRequest{}
+	req := &GetGroupRequest{}
 
-	resp := &ListMembersResponse{}
+	resp := &Group{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListMembers(
+	if err := h.h.GetGroup(
 		r.Context(),
 		req,
 		resp,
@@ -352,277 +558,1445 @@ func (h *webGroupsServiceHandler) ListMembers(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	render.Status(r, http.StatusOK)
+	render.Status(r, http.StatusCreated)
 	render.JSON(w, r, resp)
 }
 
-func RegisterGroupsServiceWeb(r chi.Router, i GroupsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webGroupsServiceHandler{
-		r: r,
-		h: i,
+func (h *webGroupsServiceHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &CreateGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	r.MethodFunc("POST", "/api/v0/accounts/groups-list", handler.ListGroups)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-get", handler.GetGroup)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-create", handler.CreateGroup)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-update", handler.UpdateGroup)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-delete", handler.DeleteGroup)
-	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/members/$ref", handler.AddMember)
-	r.MethodFunc("DELETE", "/api/v0/groups/{group_id=*}/members/{account_id}/$ref", handler.RemoveMember)
-	r.MethodFunc("GET", "/api/v0/groups/{id=*}/members/$ref", handler.ListMembers)
+	if err := h.h.CreateGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &UpdateGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.UpdateGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &DeleteGroupRequest{}
+	resp := &empty.Empty{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.DeleteGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+func (h *webGroupsServiceHandler) RestoreGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &RestoreGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RestoreGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+
+	req := &AddMemberRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.AddMember(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+
+	req := &RemoveMemberRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RemoveMember(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListMembersRequest{}
+
+	resp := &ListMembersResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListMembers(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) AddOwner(w http.ResponseWriter, r *http.Request) {
+
+	req := &AddOwnerRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.AddOwner(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) RemoveOwner(w http.ResponseWriter, r *http.Request) {
+
+	req := &RemoveOwnerRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RemoveOwner(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) ListOwners(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListOwnersRequest{}
+
+	resp := &ListOwnersResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListOwners(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) ListOwnedGroups(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListOwnedGroupsRequest{}
+
+	resp := &ListGroupsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListOwnedGroups(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func RegisterGroupsServiceWeb(r chi.Router, i GroupsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webGroupsServiceHandler{
+		r: r,
+		h: i,
+	}
+
+	r.MethodFunc("POST", "/api/v0/accounts/groups-list", handler.ListGroups)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-get", handler.GetGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-create", handler.CreateGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-update", handler.UpdateGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-delete", handler.DeleteGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-restore", handler.RestoreGroup)
+	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/members/$ref", handler.AddMember)
+	r.MethodFunc("DELETE", "/api/v0/groups/{group_id=*}/members/{account_id}/$ref", handler.RemoveMember)
+	r.MethodFunc("GET", "/api/v0/groups/{id=*}/members/$ref", handler.ListMembers)
+	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/owners/$ref", handler.AddOwner)
+	r.MethodFunc("DELETE", "/api/v0/groups/{group_id=*}/owners/{account_id}/$ref", handler.RemoveOwner)
+	r.MethodFunc("GET", "/api/v0/groups/{id=*}/owners/$ref", handler.ListOwners)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-owned", handler.ListOwnedGroups)
+}
+
+// ListAccountsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAccountsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAccountsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAccountsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAccountsRequest)(nil)
+
+// ListAccountsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAccountsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAccountsRequest) UnmarshalJSON(b []byte) error {
+	return ListAccountsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAccountsRequest)(nil)
+
+// ListAccountsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAccountsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAccountsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAccountsResponse)(nil)
+
+// ListAccountsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAccountsResponse) UnmarshalJSON(b []byte) error {
+	return ListAccountsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAccountsResponse)(nil)
+
+// GetAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetAccountRequest)(nil)
+
+// GetAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetAccountRequest) UnmarshalJSON(b []byte) error {
+	return GetAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetAccountRequest)(nil)
+
+// CreateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateAccountRequest)(nil)
+
+// CreateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateAccountRequest) UnmarshalJSON(b []byte) error {
+	return CreateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateAccountRequest)(nil)
+
+// UpdateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UpdateAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpdateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UpdateAccountRequest)(nil)
+
+// UpdateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UpdateAccountRequest) UnmarshalJSON(b []byte) error {
+	return UpdateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UpdateAccountRequest)(nil)
+
+// DeleteAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteAccountRequest)(nil)
+
+// DeleteAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteAccountRequest) UnmarshalJSON(b []byte) error {
+	return DeleteAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteAccountRequest)(nil)
+
+// RestoreAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RestoreAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RestoreAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RestoreAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RestoreAccountRequest)(nil)
+
+// RestoreAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RestoreAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RestoreAccountRequest) UnmarshalJSON(b []byte) error {
+	return RestoreAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RestoreAccountRequest)(nil)
+
+// RevokeSessionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RevokeSessionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RevokeSessionsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RevokeSessionsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RevokeSessionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RevokeSessionsRequest)(nil)
+
+// RevokeSessionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RevokeSessionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RevokeSessionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RevokeSessionsRequest) UnmarshalJSON(b []byte) error {
+	return RevokeSessionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RevokeSessionsRequest)(nil)
+
+// ValidateSessionRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateSessionRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateSessionRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidateSessionRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidateSessionRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidateSessionRequest)(nil)
+
+// ValidateSessionRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateSessionRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateSessionRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidateSessionRequest) UnmarshalJSON(b []byte) error {
+	return ValidateSessionRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidateSessionRequest)(nil)
+
+// ValidateSessionResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateSessionResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateSessionResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidateSessionResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidateSessionResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidateSessionResponse)(nil)
+
+// ValidateSessionResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateSessionResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateSessionResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidateSessionResponse) UnmarshalJSON(b []byte) error {
+	return ValidateSessionResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidateSessionResponse)(nil)
+
+// InviteGuestRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of InviteGuestRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var InviteGuestRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *InviteGuestRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := InviteGuestRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*InviteGuestRequest)(nil)
+
+// InviteGuestRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of InviteGuestRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var InviteGuestRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *InviteGuestRequest) UnmarshalJSON(b []byte) error {
+	return InviteGuestRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*InviteGuestRequest)(nil)
+
+// InvitationJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Invitation. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var InvitationJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Invitation) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := InvitationJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Invitation)(nil)
+
+// InvitationJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Invitation. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var InvitationJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Invitation) UnmarshalJSON(b []byte) error {
+	return InvitationJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Invitation)(nil)
+
+// RedeemInvitationRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RedeemInvitationRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RedeemInvitationRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RedeemInvitationRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RedeemInvitationRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RedeemInvitationRequest)(nil)
+
+// RedeemInvitationRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RedeemInvitationRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RedeemInvitationRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RedeemInvitationRequest) UnmarshalJSON(b []byte) error {
+	return RedeemInvitationRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RedeemInvitationRequest)(nil)
+
+// ResolveIdentityRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ResolveIdentityRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ResolveIdentityRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ResolveIdentityRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ResolveIdentityRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ResolveIdentityRequest)(nil)
+
+// ResolveIdentityRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ResolveIdentityRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ResolveIdentityRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ResolveIdentityRequest) UnmarshalJSON(b []byte) error {
+	return ResolveIdentityRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ResolveIdentityRequest)(nil)
+
+// ClaimJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Claim. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ClaimJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Claim) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ClaimJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Claim)(nil)
+
+// ClaimJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Claim. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ClaimJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Claim) UnmarshalJSON(b []byte) error {
+	return ClaimJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Claim)(nil)
+
+// LinkIdentityRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of LinkIdentityRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var LinkIdentityRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *LinkIdentityRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := LinkIdentityRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*LinkIdentityRequest)(nil)
+
+// LinkIdentityRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of LinkIdentityRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var LinkIdentityRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *LinkIdentityRequest) UnmarshalJSON(b []byte) error {
+	return LinkIdentityRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*LinkIdentityRequest)(nil)
+
+// UnlinkIdentityRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UnlinkIdentityRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UnlinkIdentityRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UnlinkIdentityRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UnlinkIdentityRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UnlinkIdentityRequest)(nil)
+
+// UnlinkIdentityRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UnlinkIdentityRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UnlinkIdentityRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UnlinkIdentityRequest) UnmarshalJSON(b []byte) error {
+	return UnlinkIdentityRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UnlinkIdentityRequest)(nil)
+
+// SyncAccountsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SyncAccountsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SyncAccountsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SyncAccountsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SyncAccountsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SyncAccountsRequest)(nil)
+
+// SyncAccountsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SyncAccountsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SyncAccountsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SyncAccountsRequest) UnmarshalJSON(b []byte) error {
+	return SyncAccountsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SyncAccountsRequest)(nil)
+
+// SyncAccountsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SyncAccountsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SyncAccountsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SyncAccountsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SyncAccountsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SyncAccountsResponse)(nil)
+
+// SyncAccountsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SyncAccountsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SyncAccountsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SyncAccountsResponse) UnmarshalJSON(b []byte) error {
+	return SyncAccountsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SyncAccountsResponse)(nil)
+
+// SyncChangeJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SyncChange. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SyncChangeJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SyncChange) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SyncChangeJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SyncChange)(nil)
+
+// SyncChangeJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SyncChange. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SyncChangeJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SyncChange) UnmarshalJSON(b []byte) error {
+	return SyncChangeJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SyncChange)(nil)
+
+// CreateApiKeyRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateApiKeyRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateApiKeyRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateApiKeyRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateApiKeyRequest)(nil)
+
+// CreateApiKeyRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateApiKeyRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateApiKeyRequest) UnmarshalJSON(b []byte) error {
+	return CreateApiKeyRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateApiKeyRequest)(nil)
+
+// RotateApiKeyRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RotateApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RotateApiKeyRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RotateApiKeyRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RotateApiKeyRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RotateApiKeyRequest)(nil)
+
+// RotateApiKeyRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RotateApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RotateApiKeyRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RotateApiKeyRequest) UnmarshalJSON(b []byte) error {
+	return RotateApiKeyRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RotateApiKeyRequest)(nil)
+
+// RevokeApiKeyRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RevokeApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RevokeApiKeyRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RevokeApiKeyRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RevokeApiKeyRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RevokeApiKeyRequest)(nil)
+
+// RevokeApiKeyRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RevokeApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RevokeApiKeyRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RevokeApiKeyRequest) UnmarshalJSON(b []byte) error {
+	return RevokeApiKeyRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RevokeApiKeyRequest)(nil)
+
+// AuthenticateApiKeyRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AuthenticateApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AuthenticateApiKeyRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AuthenticateApiKeyRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AuthenticateApiKeyRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AuthenticateApiKeyRequest)(nil)
+
+// AuthenticateApiKeyRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AuthenticateApiKeyRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AuthenticateApiKeyRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AuthenticateApiKeyRequest) UnmarshalJSON(b []byte) error {
+	return AuthenticateApiKeyRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AuthenticateApiKeyRequest)(nil)
+
+// ApiKeySecretJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ApiKeySecret. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ApiKeySecretJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ApiKeySecret) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ApiKeySecretJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ApiKeySecret)(nil)
+
+// ApiKeySecretJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ApiKeySecret. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ApiKeySecretJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ApiKeySecret) UnmarshalJSON(b []byte) error {
+	return ApiKeySecretJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-// ListAccountsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListAccountsRequest. This struct is safe to replace or modify but
+var _ json.Unmarshaler = (*ApiKeySecret)(nil)
+
+// ListAuditRecordsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAuditRecordsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsRequestJSONMarshaler = new(jsonpb.Marshaler)
+var ListAuditRecordsRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListAccountsRequest) MarshalJSON() ([]byte, error) {
+func (m *ListAuditRecordsRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListAccountsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ListAuditRecordsRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListAccountsRequest)(nil)
+var _ json.Marshaler = (*ListAuditRecordsRequest)(nil)
 
-// ListAccountsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListAccountsRequest. This struct is safe to replace or modify but
+// ListAuditRecordsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAuditRecordsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ListAuditRecordsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListAccountsRequest) UnmarshalJSON(b []byte) error {
-	return ListAccountsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ListAuditRecordsRequest) UnmarshalJSON(b []byte) error {
+	return ListAuditRecordsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListAccountsRequest)(nil)
+var _ json.Unmarshaler = (*ListAuditRecordsRequest)(nil)
 
-// ListAccountsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// ListAuditRecordsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAuditRecordsResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsResponseJSONMarshaler = new(jsonpb.Marshaler)
+var ListAuditRecordsResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListAccountsResponse) MarshalJSON() ([]byte, error) {
+func (m *ListAuditRecordsResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListAccountsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ListAuditRecordsResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListAccountsResponse)(nil)
+var _ json.Marshaler = (*ListAuditRecordsResponse)(nil)
 
-// ListAccountsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// ListAuditRecordsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAuditRecordsResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ListAuditRecordsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListAccountsResponse) UnmarshalJSON(b []byte) error {
-	return ListAccountsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ListAuditRecordsResponse) UnmarshalJSON(b []byte) error {
+	return ListAuditRecordsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListAccountsResponse)(nil)
+var _ json.Unmarshaler = (*ListAuditRecordsResponse)(nil)
 
-// GetAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetAccountRequest. This struct is safe to replace or modify but
+// AuditRecordJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AuditRecord. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var AuditRecordJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *AuditRecord) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := AuditRecordJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetAccountRequest)(nil)
+var _ json.Marshaler = (*AuditRecord)(nil)
 
-// GetAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetAccountRequest. This struct is safe to replace or modify but
+// AuditRecordJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AuditRecord. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var AuditRecordJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetAccountRequest) UnmarshalJSON(b []byte) error {
-	return GetAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *AuditRecord) UnmarshalJSON(b []byte) error {
+	return AuditRecordJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetAccountRequest)(nil)
+var _ json.Unmarshaler = (*AuditRecord)(nil)
 
-// CreateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// EventJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Event. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var CreateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var EventJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *CreateAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *Event) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := CreateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := EventJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*CreateAccountRequest)(nil)
+var _ json.Marshaler = (*Event)(nil)
 
-// CreateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// EventJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Event. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var CreateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var EventJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *CreateAccountRequest) UnmarshalJSON(b []byte) error {
-	return CreateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *Event) UnmarshalJSON(b []byte) error {
+	return EventJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*CreateAccountRequest)(nil)
+var _ json.Unmarshaler = (*Event)(nil)
 
-// UpdateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// AccountJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Account. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var UpdateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var AccountJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *UpdateAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *Account) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := UpdateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := AccountJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*UpdateAccountRequest)(nil)
+var _ json.Marshaler = (*Account)(nil)
 
-// UpdateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// AccountJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Account. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var UpdateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var AccountJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *UpdateAccountRequest) UnmarshalJSON(b []byte) error {
-	return UpdateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *Account) UnmarshalJSON(b []byte) error {
+	return AccountJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*UpdateAccountRequest)(nil)
+var _ json.Unmarshaler = (*Account)(nil)
 
-// DeleteAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// GuestInvitationJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GuestInvitation. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var DeleteAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var GuestInvitationJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *GuestInvitation) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := DeleteAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := GuestInvitationJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*DeleteAccountRequest)(nil)
+var _ json.Marshaler = (*GuestInvitation)(nil)
 
-// DeleteAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// GuestInvitationJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GuestInvitation. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var DeleteAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var GuestInvitationJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *DeleteAccountRequest) UnmarshalJSON(b []byte) error {
-	return DeleteAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *GuestInvitation) UnmarshalJSON(b []byte) error {
+	return GuestInvitationJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*DeleteAccountRequest)(nil)
+var _ json.Unmarshaler = (*GuestInvitation)(nil)
 
-// AccountJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of Account. This struct is safe to replace or modify but
+// ApiKeyJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ApiKey. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AccountJSONMarshaler = new(jsonpb.Marshaler)
+var ApiKeyJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *Account) MarshalJSON() ([]byte, error) {
+func (m *ApiKey) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := AccountJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ApiKeyJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*Account)(nil)
+var _ json.Marshaler = (*ApiKey)(nil)
 
-// AccountJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of Account. This struct is safe to replace or modify but
+// ApiKeyJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ApiKey. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AccountJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ApiKeyJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *Account) UnmarshalJSON(b []byte) error {
-	return AccountJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ApiKey) UnmarshalJSON(b []byte) error {
+	return ApiKeyJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*Account)(nil)
+var _ json.Unmarshaler = (*ApiKey)(nil)
 
 // IdentitiesJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Identities. This struct is safe to replace or modify but
@@ -912,6 +2286,42 @@ func (m *DeleteGroupRequest) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*DeleteGroupRequest)(nil)
 
+// RestoreGroupRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RestoreGroupRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreGroupRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RestoreGroupRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RestoreGroupRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RestoreGroupRequest)(nil)
+
+// RestoreGroupRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RestoreGroupRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreGroupRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RestoreGroupRequest) UnmarshalJSON(b []byte) error {
+	return RestoreGroupRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RestoreGroupRequest)(nil)
+
 // AddMemberRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of AddMemberRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -1056,6 +2466,186 @@ func (m *ListMembersResponse) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*ListMembersResponse)(nil)
 
+// AddOwnerRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AddOwnerRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddOwnerRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AddOwnerRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AddOwnerRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AddOwnerRequest)(nil)
+
+// AddOwnerRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AddOwnerRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddOwnerRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AddOwnerRequest) UnmarshalJSON(b []byte) error {
+	return AddOwnerRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AddOwnerRequest)(nil)
+
+// RemoveOwnerRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RemoveOwnerRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RemoveOwnerRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RemoveOwnerRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RemoveOwnerRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RemoveOwnerRequest)(nil)
+
+// RemoveOwnerRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RemoveOwnerRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RemoveOwnerRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RemoveOwnerRequest) UnmarshalJSON(b []byte) error {
+	return RemoveOwnerRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RemoveOwnerRequest)(nil)
+
+// ListOwnersRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListOwnersRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListOwnersRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListOwnersRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListOwnersRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListOwnersRequest)(nil)
+
+// ListOwnersRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListOwnersRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListOwnersRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListOwnersRequest) UnmarshalJSON(b []byte) error {
+	return ListOwnersRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListOwnersRequest)(nil)
+
+// ListOwnersResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListOwnersResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListOwnersResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListOwnersResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListOwnersResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListOwnersResponse)(nil)
+
+// ListOwnersResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListOwnersResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListOwnersResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListOwnersResponse) UnmarshalJSON(b []byte) error {
+	return ListOwnersResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListOwnersResponse)(nil)
+
+// ListOwnedGroupsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListOwnedGroupsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListOwnedGroupsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListOwnedGroupsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListOwnedGroupsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListOwnedGroupsRequest)(nil)
+
+// ListOwnedGroupsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListOwnedGroupsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListOwnedGroupsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListOwnedGroupsRequest) UnmarshalJSON(b []byte) error {
+	return ListOwnedGroupsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListOwnedGroupsRequest)(nil)
+
 // GroupJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Group. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -1,9 +1,12 @@
 package proto
 
-// BleveAccount wraps the generated Account and adds a bleve type that is used to distinguish documents in the index
+// BleveAccount wraps the generated Account and adds a bleve type that is used to distinguish documents in the index.
+// The issuer and issuer assigned id of each identity are indexed as one key, so an identity can be looked up with a
+// single term.
 type BleveAccount struct {
 	Account
-	BleveType string `json:"bleve_type"`
+	BleveType    string   `json:"bleve_type"`
+	IdentityKeys []string `json:"identity_keys"`
 }
 
 // BleveGroup wraps the generated Group and adds a bleve type that is used to distinguish documents in the index
@@ -0,0 +1,55 @@
+package proto
+
+// Types of the events published by the accounts service. Every event is published as Event message with the
+// `application/protobuf` content type on the topic matching its type, so it can be consumed with a go-micro subscriber:
+//
+//	micro.RegisterSubscriber(proto.EventAccountDisabled, service.Server(), func(ctx context.Context, e *proto.Event) error {
+//		// drop cached data of e.AccountId
+//		return nil
+//	})
+const (
+	// EventAccountCreated is published when an account was created
+	EventAccountCreated = "accounts.account.created"
+	// EventAccountUpdated is published when properties of an account were changed, the paths list them
+	EventAccountUpdated = "accounts.account.updated"
+	// EventAccountEnabled is published in addition to EventAccountUpdated when an account was enabled, and on its own
+	// when an account was enabled on its scheduled date
+	EventAccountEnabled = "accounts.account.enabled"
+	// EventAccountDisabled is published when an account was disabled, also when it expired
+	EventAccountDisabled = "accounts.account.disabled"
+	// EventAccountDeleted is published when an account was deleted, it can still be restored
+	EventAccountDeleted = "accounts.account.deleted"
+	// EventAccountRestored is published when a deleted account was restored
+	EventAccountRestored = "accounts.account.restored"
+	// EventAccountPurged is published when a deleted account was removed permanently
+	EventAccountPurged = "accounts.account.purged"
+	// EventAccountSessionsRevoked is published when the sessions of an account were revoked, explicitly or by changing
+	// its password. Tokens issued before the revocation are no longer valid. Disabling and deleting an
+	// account also invalidates its sessions, they are published as EventAccountDisabled and EventAccountDeleted.
+	EventAccountSessionsRevoked = "accounts.account.sessions_revoked"
+	// EventAccountAPIKeyRevoked is published when an api key of a service account was revoked or rotated, the previous
+	// secret of the key is no longer valid
+	EventAccountAPIKeyRevoked = "accounts.account.api_key_revoked"
+	// EventAccountInvited is published when a guest was invited, or invited again while the invitation is pending
+	EventAccountInvited = "accounts.account.invited"
+	// EventAccountInvitationRedeemed is published when a guest redeemed an invitation and can sign in
+	EventAccountInvitationRedeemed = "accounts.account.invitation_redeemed"
+	// EventGroupCreated is published when a group was created
+	EventGroupCreated = "accounts.group.created"
+	// EventGroupUpdated is published when properties of a group were changed, the paths list them
+	EventGroupUpdated = "accounts.group.updated"
+	// EventGroupDeleted is published when a group was deleted, it can still be restored
+	EventGroupDeleted = "accounts.group.deleted"
+	// EventGroupRestored is published when a deleted group was restored
+	EventGroupRestored = "accounts.group.restored"
+	// EventGroupPurged is published when a deleted group was removed permanently
+	EventGroupPurged = "accounts.group.purged"
+	// EventGroupMemberAdded is published when an account was added to a group
+	EventGroupMemberAdded = "accounts.group.member_added"
+	// EventGroupMemberRemoved is published when an account was removed from a group
+	EventGroupMemberRemoved = "accounts.group.member_removed"
+	// EventGroupOwnerAdded is published when an account was made an owner of a group
+	EventGroupOwnerAdded = "accounts.group.owner_added"
+	// EventGroupOwnerRemoved is published when an account was removed from the owners of a group
+	EventGroupOwnerRemoved = "accounts.group.owner_removed"
+)
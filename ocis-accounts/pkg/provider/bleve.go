@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/CiscoM31/godata"
 	"github.com/blevesearch/bleve"
@@ -26,65 +27,82 @@ func BuildBleveQuery(r *godata.GoDataFilterQuery) (query.Query, error) {
 func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 	if n.Token.Type == godata.FilterTokenFunc {
 		switch n.Token.Value {
-		case "startswith":
+		case "startswith", "endswith", "contains":
 			if len(n.Children) != 2 {
-				return nil, errors.New("startswith match must have two children")
+				return nil, fmt.Errorf("%s match must have two children", n.Token.Value)
 			}
 			if n.Children[0].Token.Type != godata.FilterTokenLiteral {
-				return nil, errors.New("startswith expected a literal as the first param")
+				return nil, fmt.Errorf("%s expected a literal as the first param", n.Token.Value)
 			}
 			if n.Children[1].Token.Type != godata.FilterTokenString {
-				return nil, errors.New("startswith expected a string as the second param")
-			} // remove enclosing ' of string tokens (looks like 'some ol'' string')
-			value := n.Children[1].Token.Value[1 : len(n.Children[1].Token.Value)-1]
-			// unescape '' as '
-			unescaped := strings.ReplaceAll(value, "''", "'")
-			q := bleve.NewPrefixQuery(unescaped)
+				return nil, fmt.Errorf("%s expected a string as the second param", n.Token.Value)
+			}
+			unescaped := unquote(n.Children[1].Token.Value)
+			if n.Token.Value == "startswith" {
+				q := bleve.NewPrefixQuery(unescaped)
+				q.SetField(n.Children[0].Token.Value)
+				return q, nil
+			}
+			// the remaining functions need a wildcard query, so the wildcards in the value have to be escaped
+			wildcard := wildcardEscaper.Replace(unescaped)
+			if n.Token.Value == "endswith" {
+				wildcard = "*" + wildcard
+			} else {
+				wildcard = "*" + wildcard + "*"
+			}
+			q := bleve.NewWildcardQuery(wildcard)
 			q.SetField(n.Children[0].Token.Value)
 			return q, nil
-			// TODO contains as regex?
-			// TODO endswith as regex?
 		default:
 			return nil, godata.NotImplementedError(n.Token.Value + " is not implemented.")
 		}
 	}
 	if n.Token.Type == godata.FilterTokenLogical {
 		switch n.Token.Value {
-		case "eq":
+		case "eq", "ne":
+			q, err := equalityQuery(n)
+			if err != nil {
+				return nil, err
+			}
+			if n.Token.Value == "ne" {
+				return query.NewBooleanQuery(nil, nil, []query.Query{q}), nil
+			}
+			return q, nil
+		case "gt", "ge", "lt", "le":
 			if len(n.Children) != 2 {
-				return nil, errors.New("equality match must have two children")
+				return nil, errors.New("comparison must have two children")
 			}
 			if n.Children[0].Token.Type != godata.FilterTokenLiteral {
-				return nil, errors.New("equality expected a literal on the lhs")
+				return nil, errors.New("comparison expected a literal on the lhs")
 			}
-			if n.Children[1].Token.Type == godata.FilterTokenString {
-				// for escape rules see http://docs.oasis-open.org/odata/odata/v4.01/cs01/part2-url-conventions/odata-v4.01-cs01-part2-url-conventions.html#sec_URLComponents
-				// remove enclosing ' of string tokens (looks like 'some ol'' string')
-				value := n.Children[1].Token.Value[1 : len(n.Children[1].Token.Value)-1]
-				// unescape '' as '
-				unescaped := strings.ReplaceAll(value, "''", "'")
-				// use a match query, so the field mapping, e.g. lowercase is applied to the value
-				// remember we defined the field mapping for `preferred_name` to be lowercase
-				// a term query like `preferred_name eq 'Artur'` would use `Artur` to search in the index and come up empty
-				// a match query will apply the field mapping (lowercasing `Artur` to `artur`) before doing the search
-				// TODO there is a mismatch between the LDAP and odata filters:
-				// - LDAP matching rules depend on the attribute: see https://ldapwiki.com/wiki/MatchingRule
-				// - odata has functions like `startswith`, `contains`, `tolower`, `toupper`, `matchesPattern` andy more: see http://docs.oasis-open.org/odata/odata/v4.01/odata-v4.01-part1-protocol.html#sec_BuiltinQueryFunctions
-				// - ocis-glauth should do the mapping between LDAP and odata filter
-				q := bleve.NewMatchQuery(unescaped)
-				q.SetField(n.Children[0].Token.Value)
-				return q, nil
-			} else if n.Children[1].Token.Type == godata.FilterTokenInteger {
-				v, err := strconv.ParseFloat(n.Children[1].Token.Value, 64)
+			field := n.Children[0].Token.Value
+			var v float64
+			switch n.Children[1].Token.Type {
+			case godata.FilterTokenInteger:
+				var err error
+				if v, err = strconv.ParseFloat(n.Children[1].Token.Value, 64); err != nil {
+					return nil, err
+				}
+			case godata.FilterTokenDateTime:
+				// timestamps are indexed as their seconds since the epoch
+				t, err := time.Parse(time.RFC3339Nano, n.Children[1].Token.Value)
 				if err != nil {
 					return nil, err
 				}
-				incl := true
-				q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
-				q.SetField(n.Children[0].Token.Value)
-				return q, nil
+				v = float64(t.Unix())
+				field += ".seconds"
+			default:
+				return nil, fmt.Errorf("comparison expected an int or datetime on the rhs, got %d", n.Children[1].Token.Type)
 			}
-			return nil, fmt.Errorf("equality expected a string or int on the rhs, got %d", n.Children[1].Token.Type)
+			incl := n.Token.Value == "ge" || n.Token.Value == "le"
+			var q *query.NumericRangeQuery
+			if n.Token.Value == "gt" || n.Token.Value == "ge" {
+				q = bleve.NewNumericRangeInclusiveQuery(&v, nil, &incl, nil)
+			} else {
+				q = bleve.NewNumericRangeInclusiveQuery(nil, &v, nil, &incl)
+			}
+			q.SetField(field)
+			return q, nil
 		case "and":
 			q := query.NewConjunctionQuery([]query.Query{})
 			for _, child := range n.Children {
@@ -109,7 +127,7 @@ func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 				}
 			}
 			return q, nil
-		case "Not":
+		case "not", "Not":
 			if len(n.Children) != 1 {
 				return nil, errors.New("not filter must have only one child")
 			}
@@ -126,3 +144,49 @@ func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 
 	return nil, godata.NotImplementedError(n.Token.Value + " is not implemented.")
 }
+
+// equalityQuery builds the query for an eq or ne node
+func equalityQuery(n *godata.ParseNode) (query.Query, error) {
+	if len(n.Children) != 2 {
+		return nil, errors.New("equality match must have two children")
+	}
+	if n.Children[0].Token.Type != godata.FilterTokenLiteral {
+		return nil, errors.New("equality expected a literal on the lhs")
+	}
+	if n.Children[1].Token.Type == godata.FilterTokenString {
+		// for escape rules see http://docs.oasis-open.org/odata/odata/v4.01/cs01/part2-url-conventions/odata-v4.01-cs01-part2-url-conventions.html#sec_URLComponents
+		unescaped := unquote(n.Children[1].Token.Value)
+		// use a match query, so the field mapping, e.g. lowercase is applied to the value
+		// remember we defined the field mapping for `preferred_name` to be lowercase
+		// a term query like `preferred_name eq 'Artur'` would use `Artur` to search in the index and come up empty
+		// a match query will apply the field mapping (lowercasing `Artur` to `artur`) before doing the search
+		// TODO there is a mismatch between the LDAP and odata filters:
+		// - LDAP matching rules depend on the attribute: see https://ldapwiki.com/wiki/MatchingRule
+		// - odata has functions like `startswith`, `contains`, `tolower`, `toupper`, `matchesPattern` andy more: see http://docs.oasis-open.org/odata/odata/v4.01/odata-v4.01-part1-protocol.html#sec_BuiltinQueryFunctions
+		// - ocis-glauth should do the mapping between LDAP and odata filter
+		q := bleve.NewMatchQuery(unescaped)
+		q.SetField(n.Children[0].Token.Value)
+		return q, nil
+	} else if n.Children[1].Token.Type == godata.FilterTokenBoolean {
+		q := bleve.NewBoolFieldQuery(n.Children[1].Token.Value == "true")
+		q.SetField(n.Children[0].Token.Value)
+		return q, nil
+	} else if n.Children[1].Token.Type == godata.FilterTokenInteger {
+		v, err := strconv.ParseFloat(n.Children[1].Token.Value, 64)
+		if err != nil {
+			return nil, err
+		}
+		incl := true
+		q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
+		q.SetField(n.Children[0].Token.Value)
+		return q, nil
+	}
+	return nil, fmt.Errorf("equality expected a string, bool or int on the rhs, got %d", n.Children[1].Token.Type)
+}
+
+var wildcardEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+
+// unquote removes the enclosing ' of string tokens (looks like 'some ol” string') and unescapes ” as '
+func unquote(token string) string {
+	return strings.ReplaceAll(token[1:len(token)-1], "''", "'")
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// BuildLDAPFilter converts a GoDataFilterQuery into an ldap filter. The attributes map the odata property names to
+// ldap attribute names, properties without a mapping cannot be used in the filter.
+func BuildLDAPFilter(r *godata.GoDataFilterQuery, attributes map[string]string) (string, error) {
+	return recursiveBuildFilter(r.Tree, attributes)
+}
+
+// Builds the filter recursively using DFS
+func recursiveBuildFilter(n *godata.ParseNode, attributes map[string]string) (string, error) {
+	if n.Token.Type == godata.FilterTokenFunc {
+		switch n.Token.Value {
+		case "startswith", "endswith", "contains":
+			attr, value, err := ldapOperands(n, attributes)
+			if err != nil {
+				return "", err
+			}
+			switch n.Token.Value {
+			case "startswith":
+				return fmt.Sprintf("(%s=%s*)", attr, value), nil
+			case "endswith":
+				return fmt.Sprintf("(%s=*%s)", attr, value), nil
+			default:
+				return fmt.Sprintf("(%s=*%s*)", attr, value), nil
+			}
+		default:
+			return "", godata.NotImplementedError(n.Token.Value + " is not implemented.")
+		}
+	}
+	if n.Token.Type == godata.FilterTokenLogical {
+		switch n.Token.Value {
+		case "eq", "ap":
+			attr, value, err := ldapOperands(n, attributes)
+			if err != nil {
+				return "", err
+			}
+			if n.Token.Value == "ap" {
+				return fmt.Sprintf("(%s~=%s)", attr, value), nil
+			}
+			return fmt.Sprintf("(%s=%s)", attr, value), nil
+		case "ne":
+			attr, value, err := ldapOperands(n, attributes)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("(!(%s=%s))", attr, value), nil
+		case "gt", "ge", "lt", "le":
+			attr, value, err := ldapOperands(n, attributes)
+			if err != nil {
+				return "", err
+			}
+			if n.Children[1].Token.Type != godata.FilterTokenInteger {
+				return "", errors.New("comparison expected an int on the rhs")
+			}
+			// ldap only knows >= and <=, the strict comparisons are negated
+			switch n.Token.Value {
+			case "ge":
+				return fmt.Sprintf("(%s>=%s)", attr, value), nil
+			case "le":
+				return fmt.Sprintf("(%s<=%s)", attr, value), nil
+			case "gt":
+				return fmt.Sprintf("(!(%s<=%s))", attr, value), nil
+			default:
+				return fmt.Sprintf("(!(%s>=%s))", attr, value), nil
+			}
+		case "and", "or":
+			op := "&"
+			if n.Token.Value == "or" {
+				op = "|"
+			}
+			var sb strings.Builder
+			sb.WriteString("(" + op)
+			for _, child := range n.Children {
+				subFilter, err := recursiveBuildFilter(child, attributes)
+				if err != nil {
+					return "", err
+				}
+				sb.WriteString(subFilter)
+			}
+			sb.WriteString(")")
+			return sb.String(), nil
+		case "not", "Not":
+			if len(n.Children) != 1 {
+				return "", errors.New("not filter must have only one child")
+			}
+			subFilter, err := recursiveBuildFilter(n.Children[0], attributes)
+			if err != nil {
+				return "", err
+			}
+			return "(!" + subFilter + ")", nil
+		default:
+			return "", godata.NotImplementedError(n.Token.Value + " is not implemented.")
+		}
+	}
+
+	return "", godata.NotImplementedError(n.Token.Value + " is not implemented.")
+}
+
+// ldapOperands returns the mapped attribute of the lhs and the escaped value of the rhs of a binary node
+func ldapOperands(n *godata.ParseNode, attributes map[string]string) (string, string, error) {
+	if len(n.Children) != 2 {
+		return "", "", fmt.Errorf("%s must have two children", n.Token.Value)
+	}
+	if n.Children[0].Token.Type != godata.FilterTokenLiteral {
+		return "", "", fmt.Errorf("%s expected a literal on the lhs", n.Token.Value)
+	}
+	attr, ok := attributes[n.Children[0].Token.Value]
+	if !ok || attr == "" {
+		return "", "", fmt.Errorf("%s can not be used in an ldap filter", n.Children[0].Token.Value)
+	}
+
+	switch n.Children[1].Token.Type {
+	case godata.FilterTokenString:
+		// remove enclosing ' of string tokens (looks like 'some ol'' string') and unescape '' as '
+		value := n.Children[1].Token.Value[1 : len(n.Children[1].Token.Value)-1]
+		return attr, ldap.EscapeFilter(strings.ReplaceAll(value, "''", "'")), nil
+	case godata.FilterTokenInteger:
+		if _, err := strconv.ParseInt(n.Children[1].Token.Value, 10, 64); err != nil {
+			return "", "", err
+		}
+		return attr, n.Children[1].Token.Value, nil
+	default:
+		return "", "", fmt.Errorf("%s expected a string or int on the rhs, got %d", n.Token.Value, n.Children[1].Token.Type)
+	}
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/CiscoM31/godata"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+var (
+	// ErrNotFound is returned when an account or group does not exist in the directory
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidCredentials is returned when a login name or password is not accepted by the directory
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrInsecureConnection is returned when the directory is configured without tls and insecure mode is not enabled
+	ErrInsecureConnection = errors.New("refusing to connect to the ldap server without tls, insecure mode is not enabled")
+)
+
+// LDAP reads accounts and groups from an LDAP directory using the configured attribute mapping.
+// It is read only, accounts and groups have to be managed in the directory.
+type LDAP struct {
+	cfg config.LDAP
+}
+
+// NewLDAP returns a new LDAP provider
+func NewLDAP(cfg config.LDAP) *LDAP {
+	return &LDAP{cfg: cfg}
+}
+
+// AccountAttributes maps the account properties that can be used in filters to ldap attributes
+func (l *LDAP) AccountAttributes() map[string]string {
+	return map[string]string{
+		"id":                           l.cfg.Schema.AccountID,
+		"preferred_name":               l.cfg.Schema.Username,
+		"on_premises_sam_account_name": l.cfg.Schema.Username,
+		"display_name":                 l.cfg.Schema.DisplayName,
+		"mail":                         l.cfg.Schema.Mail,
+		"uid_number":                   l.cfg.Schema.UIDNumber,
+		"gid_number":                   l.cfg.Schema.GIDNumber,
+	}
+}
+
+// GroupAttributes maps the group properties that can be used in filters to ldap attributes
+func (l *LDAP) GroupAttributes() map[string]string {
+	return map[string]string{
+		"id":                           l.cfg.Schema.GroupID,
+		"on_premises_sam_account_name": l.cfg.Schema.GroupName,
+		"display_name":                 l.cfg.Schema.GroupName,
+		"gid_number":                   l.cfg.Schema.GIDNumber,
+	}
+}
+
+// GetAccount returns the account with the given id
+func (l *LDAP) GetAccount(id string) (*proto.Account, error) {
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := l.search(conn, l.cfg.BaseDN, ldap.ScopeWholeSubtree, l.accountFilter(l.cfg.Schema.AccountID, id), l.accountAttributeNames())
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, ErrNotFound
+	}
+	return l.toAccount(conn, entries[0]), nil
+}
+
+// ListAccounts returns all accounts matching the odata filter query
+func (l *LDAP) ListAccounts(query string) ([]*proto.Account, error) {
+	filter, err := l.filter(l.cfg.UserFilter, query, l.AccountAttributes())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := l.search(conn, l.cfg.BaseDN, ldap.ScopeWholeSubtree, filter, l.accountAttributeNames())
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*proto.Account, 0, len(entries))
+	for _, e := range entries {
+		accounts = append(accounts, l.toAccount(conn, e))
+	}
+	return accounts, nil
+}
+
+// Authenticate returns the account with the given login name if the directory accepts a bind with the password
+func (l *LDAP) Authenticate(login, password string) (*proto.Account, error) {
+	// an empty password would be an unauthenticated bind, which most servers accept
+	if login == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := l.search(conn, l.cfg.BaseDN, ldap.ScopeWholeSubtree, l.accountFilter(l.cfg.Schema.Username, login), l.accountAttributeNames())
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+
+	// bind on a separate connection, the search connection keeps the service bind
+	userConn, err := l.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer userConn.Close()
+	if err := userConn.Bind(entries[0].DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	return l.toAccount(conn, entries[0]), nil
+}
+
+// GetGroup returns the group with the given id
+func (l *LDAP) GetGroup(id string) (*proto.Group, error) {
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := l.search(conn, l.cfg.BaseDN, ldap.ScopeWholeSubtree, l.groupFilter(l.cfg.Schema.GroupID, id), l.groupAttributeNames())
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, ErrNotFound
+	}
+	return l.toGroup(entries[0]), nil
+}
+
+// ListGroups returns all groups matching the odata filter query
+func (l *LDAP) ListGroups(query string) ([]*proto.Group, error) {
+	filter, err := l.filter(l.cfg.GroupFilter, query, l.GroupAttributes())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := l.search(conn, l.cfg.BaseDN, ldap.ScopeWholeSubtree, filter, l.groupAttributeNames())
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*proto.Group, 0, len(entries))
+	for _, e := range entries {
+		groups = append(groups, l.toGroup(e))
+	}
+	return groups, nil
+}
+
+// ListMembers returns the accounts that are a member of the group with the given id
+func (l *LDAP) ListMembers(id string) ([]*proto.Account, error) {
+	g, err := l.GetGroup(id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := l.search(conn, l.cfg.BaseDN, ldap.ScopeWholeSubtree, l.accountFilter(l.cfg.Schema.Groups, g.OnPremisesDistinguishedName), l.accountAttributeNames())
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*proto.Account, 0, len(entries))
+	for _, e := range entries {
+		a := l.toAccount(nil, e)
+		a.MemberOf = nil // always hide groups when listing members
+		members = append(members, a)
+	}
+	return members, nil
+}
+
+// connect opens a connection to the directory. Binds send passwords, so plain connections are only opened in
+// insecure mode.
+func (l *LDAP) connect() (*ldap.Conn, error) {
+	address := net.JoinHostPort(l.cfg.Hostname, strconv.Itoa(l.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: l.cfg.Hostname}
+
+	switch l.cfg.TLS {
+	case "ldaps":
+		conn, err := ldap.DialURL("ldaps://"+address, ldap.DialWithTLSConfig(tlsConfig))
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to ldap server: %w", err)
+		}
+		return conn, nil
+	case "", "starttls":
+		conn, err := ldap.DialURL("ldap://" + address)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to ldap server: %w", err)
+		}
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("could not start tls with ldap server: %w", err)
+		}
+		return conn, nil
+	case "none":
+		if !l.cfg.Insecure {
+			return nil, ErrInsecureConnection
+		}
+		conn, err := ldap.DialURL("ldap://" + address)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to ldap server: %w", err)
+		}
+		return conn, nil
+	default:
+		return nil, fmt.Errorf("unknown ldap tls mode %s", l.cfg.TLS)
+	}
+}
+
+// dial opens a connection to the directory that is bound with the configured service account
+func (l *LDAP) dial() (*ldap.Conn, error) {
+	conn, err := l.connect()
+	if err != nil {
+		return nil, err
+	}
+	if l.cfg.BindDN != "" {
+		if err := conn.Bind(l.cfg.BindDN, l.cfg.BindPassword); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("could not bind to ldap server: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+func (l *LDAP) search(conn *ldap.Conn, base string, scope int, filter string, attributes []string) ([]*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(base, scope, ldap.NeverDerefAliases, 0, 0, false, filter, attributes, nil)
+	res, err := conn.Search(req)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not search ldap server: %w", err)
+	}
+	return res.Entries, nil
+}
+
+// filter combines the base filter with the translated odata filter query
+func (l *LDAP) filter(base, query string, attributes map[string]string) (string, error) {
+	if query == "" {
+		return base, nil
+	}
+	q, err := godata.ParseFilterString(query)
+	if err != nil {
+		return "", err
+	}
+	f, err := BuildLDAPFilter(q, attributes)
+	if err != nil {
+		return "", err
+	}
+	return "(&" + base + f + ")", nil
+}
+
+func (l *LDAP) accountFilter(attribute, value string) string {
+	return fmt.Sprintf("(&%s(%s=%s))", l.cfg.UserFilter, attribute, ldap.EscapeFilter(value))
+}
+
+func (l *LDAP) groupFilter(attribute, value string) string {
+	return fmt.Sprintf("(&%s(%s=%s))", l.cfg.GroupFilter, attribute, ldap.EscapeFilter(value))
+}
+
+func (l *LDAP) accountAttributeNames() []string {
+	s := l.cfg.Schema
+	return []string{s.AccountID, s.Username, s.DisplayName, s.Mail, s.Groups, s.UIDNumber, s.GIDNumber}
+}
+
+func (l *LDAP) groupAttributeNames() []string {
+	s := l.cfg.Schema
+	return []string{s.GroupID, s.GroupName, s.GIDNumber}
+}
+
+// toAccount maps an entry to an account, the groups are looked up when a connection is given
+func (l *LDAP) toAccount(conn *ldap.Conn, e *ldap.Entry) *proto.Account {
+	s := l.cfg.Schema
+	a := &proto.Account{
+		Id:                          e.GetAttributeValue(s.AccountID),
+		AccountEnabled:              true,
+		DisplayName:                 e.GetAttributeValue(s.DisplayName),
+		PreferredName:               e.GetAttributeValue(s.Username),
+		OnPremisesSamAccountName:    e.GetAttributeValue(s.Username),
+		Mail:                        e.GetAttributeValue(s.Mail),
+		UidNumber:                   parseNumber(e.GetAttributeValue(s.UIDNumber)),
+		GidNumber:                   parseNumber(e.GetAttributeValue(s.GIDNumber)),
+		OnPremisesSyncEnabled:       true,
+		OnPremisesImmutableId:       e.GetAttributeValue(s.AccountID),
+		OnPremisesDistinguishedName: e.DN,
+		MemberOf:                    []*proto.Group{},
+	}
+	if conn == nil {
+		return a
+	}
+	for _, dn := range e.GetAttributeValues(s.Groups) {
+		entries, err := l.search(conn, dn, ldap.ScopeBaseObject, l.cfg.GroupFilter, l.groupAttributeNames())
+		if err != nil || len(entries) != 1 {
+			// not a group we know about
+			continue
+		}
+		a.MemberOf = append(a.MemberOf, l.toGroup(entries[0]))
+	}
+	return a
+}
+
+func (l *LDAP) toGroup(e *ldap.Entry) *proto.Group {
+	s := l.cfg.Schema
+	return &proto.Group{
+		Id:                          e.GetAttributeValue(s.GroupID),
+		DisplayName:                 e.GetAttributeValue(s.GroupName),
+		OnPremisesSamAccountName:    e.GetAttributeValue(s.GroupName),
+		GidNumber:                   parseNumber(e.GetAttributeValue(s.GIDNumber)),
+		OnPremisesSyncEnabled:       true,
+		OnPremisesImmutableId:       e.GetAttributeValue(s.GroupID),
+		OnPremisesDistinguishedName: e.DN,
+	}
+}
+
+// parseNumber returns 0 for missing or invalid numbers
+func parseNumber(s string) int64 {
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
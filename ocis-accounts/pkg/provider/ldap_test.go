@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/CiscoM31/godata"
+	ldapserver "github.com/nmcclain/ldap"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = config.LDAPSchema{
+	AccountID:   "entryUUID",
+	Username:    "uid",
+	DisplayName: "displayName",
+	Mail:        "mail",
+	Groups:      "memberOf",
+	UIDNumber:   "uidNumber",
+	GIDNumber:   "gidNumber",
+	GroupID:     "entryUUID",
+	GroupName:   "cn",
+}
+
+func TestBuildLDAPFilter(t *testing.T) {
+	attributes := NewLDAP(config.LDAP{Schema: testSchema}).AccountAttributes()
+
+	tests := []struct {
+		query  string
+		filter string
+	}{
+		{"preferred_name eq 'einstein'", "(uid=einstein)"},
+		{"mail eq 'o''reilly@example.org'", "(mail=o'reilly@example.org)"},
+		{"display_name eq 'a*(b)'", "(displayName=a\\2a\\28b\\29)"},
+		{"startswith(display_name,'Al')", "(displayName=Al*)"},
+		{"uid_number ge 20000 and uid_number lt 30000", "(&(uidNumber>=20000)(!(uidNumber>=30000)))"},
+		{"mail eq 'a@example.org' or mail ne 'b@example.org'", "(|(mail=a@example.org)(!(mail=b@example.org)))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := godata.ParseFilterString(tt.query)
+			require.NoError(t, err)
+			filter, err := BuildLDAPFilter(q, attributes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.filter, filter)
+		})
+	}
+
+	// properties without a mapping are rejected
+	q, err := godata.ParseFilterString("description eq 'x'")
+	require.NoError(t, err)
+	_, err = BuildLDAPFilter(q, attributes)
+	assert.Error(t, err)
+}
+
+// testDirectory is a minimal in-process directory, filters are applied by the server
+type testDirectory struct {
+	entries   []*ldapserver.Entry
+	passwords map[string]string
+}
+
+func (d testDirectory) Bind(bindDN, bindSimplePw string, conn net.Conn) (ldapserver.LDAPResultCode, error) {
+	if pw, ok := d.passwords[bindDN]; ok && pw == bindSimplePw {
+		return ldapserver.LDAPResultSuccess, nil
+	}
+	return ldapserver.LDAPResultInvalidCredentials, nil
+}
+
+func (d testDirectory) Search(boundDN string, req ldapserver.SearchRequest, conn net.Conn) (ldapserver.ServerSearchResult, error) {
+	entries := []*ldapserver.Entry{}
+	for _, e := range d.entries {
+		if strings.HasSuffix(e.DN, req.BaseDN) {
+			entries = append(entries, e)
+		}
+	}
+	return ldapserver.ServerSearchResult{Entries: entries, ResultCode: ldapserver.LDAPResultSuccess}, nil
+}
+
+func entry(dn string, attributes map[string][]string) *ldapserver.Entry {
+	e := &ldapserver.Entry{DN: dn}
+	for name, values := range attributes {
+		e.Attributes = append(e.Attributes, &ldapserver.EntryAttribute{Name: name, Values: values})
+	}
+	return e
+}
+
+// startTestDirectory serves the test entries on a random port, the returned func stops the server
+func startTestDirectory(t *testing.T) (*LDAP, func()) {
+	d := testDirectory{
+		entries: []*ldapserver.Entry{
+			entry("uid=einstein,ou=users,dc=example,dc=org", map[string][]string{
+				"objectClass": {"posixAccount"},
+				"entryUUID":   {"4c510ada-c86b-4815-8820-42cdf82c3d51"},
+				"uid":         {"einstein"},
+				"displayName": {"Albert Einstein"},
+				"mail":        {"einstein@example.org"},
+				"uidNumber":   {"20000"},
+				"gidNumber":   {"30000"},
+				"memberOf":    {"cn=users,ou=groups,dc=example,dc=org", "cn=unknown,ou=groups,dc=example,dc=org"},
+			}),
+			entry("uid=marie,ou=users,dc=example,dc=org", map[string][]string{
+				"objectClass": {"posixAccount"},
+				"entryUUID":   {"f7fbf8c8-139b-4376-b307-cf0a8c2d0d9c"},
+				"uid":         {"marie"},
+				"displayName": {"Marie Curie"},
+				"mail":        {"marie@example.org"},
+				"uidNumber":   {"20001"},
+				"gidNumber":   {"30000"},
+			}),
+			entry("cn=users,ou=groups,dc=example,dc=org", map[string][]string{
+				"objectClass": {"posixGroup"},
+				"entryUUID":   {"509a9dcd-bb37-4f4f-a01a-19dca27d9cfa"},
+				"cn":          {"users"},
+				"gidNumber":   {"30000"},
+			}),
+		},
+		passwords: map[string]string{
+			"cn=admin,dc=example,dc=org":              "admin",
+			"uid=einstein,ou=users,dc=example,dc=org": "relativity",
+		},
+	}
+
+	s := ldapserver.NewServer()
+	s.EnforceLDAP = true
+	s.BindFunc("", d)
+	s.SearchFunc("", d)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(ln)
+
+	return NewLDAP(config.LDAP{
+		Hostname:     "127.0.0.1",
+		Port:         ln.Addr().(*net.TCPAddr).Port,
+		BaseDN:       "dc=example,dc=org",
+		UserFilter:   "(objectClass=posixAccount)",
+		GroupFilter:  "(objectClass=posixGroup)",
+		BindDN:       "cn=admin,dc=example,dc=org",
+		BindPassword: "admin",
+		TLS:          "none",
+		Insecure:     true,
+		Schema:       testSchema,
+	}), func() { ln.Close() }
+}
+
+func TestLDAPAccounts(t *testing.T) {
+	l, stop := startTestDirectory(t)
+	defer stop()
+
+	a, err := l.GetAccount("4c510ada-c86b-4815-8820-42cdf82c3d51")
+	require.NoError(t, err)
+	assert.Equal(t, "einstein", a.OnPremisesSamAccountName)
+	assert.Equal(t, "Albert Einstein", a.DisplayName)
+	assert.Equal(t, "einstein@example.org", a.Mail)
+	assert.Equal(t, int64(20000), a.UidNumber)
+	assert.Equal(t, int64(30000), a.GidNumber)
+	assert.Equal(t, "uid=einstein,ou=users,dc=example,dc=org", a.OnPremisesDistinguishedName)
+	// groups outside of the group filter are dropped
+	require.Len(t, a.MemberOf, 1)
+	assert.Equal(t, "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa", a.MemberOf[0].Id)
+
+	_, err = l.GetAccount("does-not-exist")
+	assert.Equal(t, ErrNotFound, err)
+
+	accounts, err := l.ListAccounts("")
+	require.NoError(t, err)
+	assert.Len(t, accounts, 2)
+
+	accounts, err = l.ListAccounts("mail eq 'marie@example.org'")
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "marie", accounts[0].PreferredName)
+
+	accounts, err = l.ListAccounts("startswith(display_name,'Albert')")
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "einstein", accounts[0].PreferredName)
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	l, stop := startTestDirectory(t)
+	defer stop()
+
+	a, err := l.Authenticate("einstein", "relativity")
+	require.NoError(t, err)
+	assert.Equal(t, "4c510ada-c86b-4815-8820-42cdf82c3d51", a.Id)
+
+	_, err = l.Authenticate("einstein", "wrong")
+	assert.Equal(t, ErrInvalidCredentials, err)
+
+	// an empty password must never result in an unauthenticated bind
+	_, err = l.Authenticate("einstein", "")
+	assert.Equal(t, ErrInvalidCredentials, err)
+
+	_, err = l.Authenticate("nobody", "relativity")
+	assert.Equal(t, ErrInvalidCredentials, err)
+}
+
+func TestLDAPGroups(t *testing.T) {
+	l, stop := startTestDirectory(t)
+	defer stop()
+
+	groups, err := l.ListGroups("on_premises_sam_account_name eq 'users'")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa", groups[0].Id)
+	assert.Equal(t, int64(30000), groups[0].GidNumber)
+
+	g, err := l.GetGroup("509a9dcd-bb37-4f4f-a01a-19dca27d9cfa")
+	require.NoError(t, err)
+	assert.Equal(t, "users", g.DisplayName)
+
+	members, err := l.ListMembers("509a9dcd-bb37-4f4f-a01a-19dca27d9cfa")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "einstein", members[0].PreferredName)
+}
+
+func TestLDAPRequiresTLS(t *testing.T) {
+	l, stop := startTestDirectory(t)
+	defer stop()
+
+	// plain connections are refused unless insecure mode is enabled
+	cfg := l.cfg
+	cfg.Insecure = false
+	_, err := NewLDAP(cfg).GetAccount("4c510ada-c86b-4815-8820-42cdf82c3d51")
+	assert.Equal(t, ErrInsecureConnection, err)
+
+	// the test directory does not support starttls, the bind must not fall back to a plain connection
+	cfg.TLS = "starttls"
+	_, err = NewLDAP(cfg).Authenticate("einstein", "relativity")
+	assert.Error(t, err)
+	assert.NotEqual(t, ErrInvalidCredentials, err)
+
+	cfg.TLS = "ssl"
+	_, err = NewLDAP(cfg).GetAccount("4c510ada-c86b-4815-8820-42cdf82c3d51")
+	assert.Error(t, err)
+}
@@ -0,0 +1,326 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// userAttributes maps the user attributes that can be used in filters to account properties
+var userAttributes = map[string]string{
+	"id":                "id",
+	"externalid":        "on_premises_immutable_id",
+	"username":          "preferred_name",
+	"displayname":       "display_name",
+	"name.formatted":    "display_name",
+	"emails":            "mail",
+	"emails.value":      "mail",
+	"active":            "account_enabled",
+	"meta.created":      "created_date_time",
+	"meta.lastmodified": "last_modified_date_time",
+}
+
+// groupAttributes maps the group attributes that can be used in filters to group properties
+var groupAttributes = map[string]string{
+	"id":           "id",
+	"externalid":   "on_premises_immutable_id",
+	"displayname":  "display_name",
+	"meta.created": "created_date_time",
+}
+
+// dateAttributes are compared as datetime instead of string values
+var dateAttributes = map[string]struct{}{
+	"created_date_time":       {},
+	"last_modified_date_time": {},
+}
+
+type filterTokenType int
+
+const (
+	tokenWord filterTokenType = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type filterToken struct {
+	typ   filterTokenType
+	value string
+}
+
+// tokenizeFilter splits a scim filter into words, quoted strings, parentheses and brackets
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	tokens := []filterToken{}
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ':
+			i++
+		case c == '(':
+			tokens = append(tokens, filterToken{tokenOpenParen, "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{tokenCloseParen, ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, filterToken{tokenOpenBracket, "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, filterToken{tokenCloseBracket, "]"})
+			i++
+		case c == '"':
+			// strings are json strings, find the closing quote and let the json decoder handle the escapes
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			var value string
+			if err := json.Unmarshal([]byte(filter[i:j+1]), &value); err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %v", i, err)
+			}
+			tokens = append(tokens, filterToken{tokenString, value})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter) && !strings.ContainsRune(" ()[]\"", rune(filter[j])); j++ {
+			}
+			tokens = append(tokens, filterToken{tokenWord, filter[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// filterParser translates scim filters to odata filters
+type filterParser struct {
+	tokens     []filterToken
+	pos        int
+	attributes map[string]string
+	schema     string
+}
+
+// translateFilter converts a scim filter into an odata filter using the given attribute mapping.
+// Attributes may be prefixed with the schema urn of the resource.
+func translateFilter(filter, schema string, attributes map[string]string) (string, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return "", err
+	}
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("empty filter")
+	}
+	p := &filterParser{tokens: tokens, attributes: attributes, schema: strings.ToLower(schema) + ":"}
+	q, err := p.parseOr("")
+	if err != nil {
+		return "", err
+	}
+	if p.pos < len(p.tokens) {
+		return "", fmt.Errorf("unexpected '%s'", p.tokens[p.pos].value)
+	}
+	return q, nil
+}
+
+func (p *filterParser) peek() *filterToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, fmt.Errorf("unexpected end of filter")
+	}
+	p.pos++
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *filterParser) expect(typ filterTokenType, value string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.typ != typ {
+		return fmt.Errorf("expected '%s' but got '%s'", value, t.value)
+	}
+	return nil
+}
+
+// isKeyword checks if the next token is the given case insensitive keyword
+func (p *filterParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t != nil && t.typ == tokenWord && strings.EqualFold(t.value, keyword)
+}
+
+// parseOr parses a list of and expressions joined by or. The prefix is the parent attribute of value paths.
+func (p *filterParser) parseOr(prefix string) (string, error) {
+	q, err := p.parseAnd(prefix)
+	if err != nil {
+		return "", err
+	}
+	for p.isKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd(prefix)
+		if err != nil {
+			return "", err
+		}
+		q = "(" + q + " or " + right + ")"
+	}
+	return q, nil
+}
+
+func (p *filterParser) parseAnd(prefix string) (string, error) {
+	q, err := p.parseUnary(prefix)
+	if err != nil {
+		return "", err
+	}
+	for p.isKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary(prefix)
+		if err != nil {
+			return "", err
+		}
+		q = "(" + q + " and " + right + ")"
+	}
+	return q, nil
+}
+
+func (p *filterParser) parseUnary(prefix string) (string, error) {
+	if p.isKeyword("not") {
+		p.pos++
+		if err := p.expect(tokenOpenParen, "("); err != nil {
+			return "", err
+		}
+		q, err := p.parseOr(prefix)
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect(tokenCloseParen, ")"); err != nil {
+			return "", err
+		}
+		return "not (" + q + ")", nil
+	}
+	if t := p.peek(); t != nil && t.typ == tokenOpenParen {
+		p.pos++
+		q, err := p.parseOr(prefix)
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect(tokenCloseParen, ")"); err != nil {
+			return "", err
+		}
+		return q, nil
+	}
+	return p.parseComparison(prefix)
+}
+
+func (p *filterParser) parseComparison(prefix string) (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if t.typ != tokenWord {
+		return "", fmt.Errorf("expected an attribute but got '%s'", t.value)
+	}
+	attr := strings.TrimPrefix(strings.ToLower(t.value), p.schema)
+
+	// value paths like emails[value eq "x"] filter on the sub attributes
+	if next := p.peek(); next != nil && next.typ == tokenOpenBracket {
+		if prefix != "" {
+			return "", fmt.Errorf("nested value paths are not supported")
+		}
+		p.pos++
+		q, err := p.parseOr(attr + ".")
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect(tokenCloseBracket, "]"); err != nil {
+			return "", err
+		}
+		return q, nil
+	}
+
+	property, ok := p.attributes[prefix+attr]
+	if !ok {
+		return "", fmt.Errorf("attribute '%s' can not be used in filters", prefix+attr)
+	}
+
+	op, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if op.typ != tokenWord {
+		return "", fmt.Errorf("expected an operator but got '%s'", op.value)
+	}
+	operator := strings.ToLower(op.value)
+	if operator == "pr" {
+		return "", fmt.Errorf("the pr operator is not supported")
+	}
+
+	v, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	value, err := p.value(property, v)
+	if err != nil {
+		return "", err
+	}
+
+	_, isDate := dateAttributes[property]
+	switch operator {
+	case "eq", "ne":
+		if isDate {
+			return "", fmt.Errorf("dates can only be compared with gt, ge, lt and le")
+		}
+		return fmt.Sprintf("%s %s %s", property, operator, value), nil
+	case "gt", "ge", "lt", "le":
+		if !isDate {
+			return "", fmt.Errorf("the %s operator can only be used with dates", operator)
+		}
+		return fmt.Sprintf("%s %s %s", property, operator, value), nil
+	case "co", "sw", "ew":
+		if v.typ != tokenString {
+			return "", fmt.Errorf("the %s operator expects a string", operator)
+		}
+		function := map[string]string{"co": "contains", "sw": "startswith", "ew": "endswith"}[operator]
+		return fmt.Sprintf("%s(%s,%s)", function, property, value), nil
+	default:
+		return "", fmt.Errorf("unknown operator '%s'", op.value)
+	}
+}
+
+// value converts a comparison value to an odata literal
+func (p *filterParser) value(property string, t filterToken) (string, error) {
+	if t.typ == tokenString {
+		if _, ok := dateAttributes[property]; ok {
+			d, err := time.Parse(time.RFC3339Nano, t.value)
+			if err != nil {
+				return "", fmt.Errorf("invalid date '%s'", t.value)
+			}
+			return d.UTC().Format(time.RFC3339), nil
+		}
+		return "'" + strings.ReplaceAll(t.value, "'", "''") + "'", nil
+	}
+	if t.typ != tokenWord {
+		return "", fmt.Errorf("expected a value but got '%s'", t.value)
+	}
+	switch strings.ToLower(t.value) {
+	case "true", "false":
+		return strings.ToLower(t.value), nil
+	case "null":
+		return "", fmt.Errorf("comparing with null is not supported")
+	}
+	if _, err := strconv.ParseInt(t.value, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid value '%s'", t.value)
+	}
+	return t.value, nil
+}
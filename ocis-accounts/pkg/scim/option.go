@@ -0,0 +1,66 @@
+package scim
+
+import (
+	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Logger          log.Logger
+	Root            string
+	Token           string
+	AccountsService proto.AccountsServiceHandler
+	GroupsService   proto.GroupsServiceHandler
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Root: "/scim/v2",
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// Logger provides a function to set the logger option.
+func Logger(val log.Logger) Option {
+	return func(o *Options) {
+		o.Logger = val
+	}
+}
+
+// Root provides a function to set the path the handler is mounted on, it is used to build resource locations.
+func Root(val string) Option {
+	return func(o *Options) {
+		o.Root = val
+	}
+}
+
+// Token provides a function to set the bearer token clients have to present.
+func Token(val string) Option {
+	return func(o *Options) {
+		o.Token = val
+	}
+}
+
+// AccountsService provides a function to set the accounts service option.
+func AccountsService(val proto.AccountsServiceHandler) Option {
+	return func(o *Options) {
+		o.AccountsService = val
+	}
+}
+
+// GroupsService provides a function to set the groups service option.
+func GroupsService(val proto.GroupsServiceHandler) Option {
+	return func(o *Options) {
+		o.GroupsService = val
+	}
+}
@@ -0,0 +1,236 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// emailValuePath matches paths like emails[type eq "work"].value, the primary email is the only stored address
+var emailValuePath = regexp.MustCompile(`^emails(\[[^\]]*\])?\.value$`)
+
+// memberValuePath matches paths like members[value eq "id"]
+var memberValuePath = regexp.MustCompile(`^members\[value eq "([^"]*)"\]$`)
+
+// applyUserPatch applies the patch operations to the user
+func applyUserPatch(u *User, ops []PatchOperation) error {
+	return applyPatch(ops, schemaUser, func(op, path string, value json.RawMessage) error {
+		remove := op == "remove"
+		switch {
+		case path == "username":
+			if remove {
+				return badRequest("mutability", "userName can not be removed")
+			}
+			return decodeString(value, &u.UserName)
+		case path == "displayname" || path == "name.formatted":
+			u.DisplayName = ""
+			u.Name = nil
+			if remove {
+				return nil
+			}
+			return decodeString(value, &u.DisplayName)
+		case path == "name":
+			u.DisplayName = ""
+			u.Name = nil
+			if remove {
+				return nil
+			}
+			if err := json.Unmarshal(value, &u.Name); err != nil {
+				return badRequest("invalidValue", "invalid value for name: %v", err)
+			}
+			return nil
+		case strings.HasPrefix(path, "name."):
+			// only the formatted name is stored
+			return nil
+		case path == "externalid":
+			u.ExternalID = ""
+			if remove {
+				return nil
+			}
+			return decodeString(value, &u.ExternalID)
+		case path == "active":
+			if remove {
+				return badRequest("mutability", "active can not be removed")
+			}
+			active, err := decodeBool(value)
+			if err != nil {
+				return err
+			}
+			u.Active = &active
+			return nil
+		case path == "password":
+			if remove {
+				return badRequest("mutability", "password can not be removed")
+			}
+			return decodeString(value, &u.Password)
+		case path == "emails":
+			u.Emails = nil
+			if remove {
+				return nil
+			}
+			if err := json.Unmarshal(value, &u.Emails); err != nil {
+				return badRequest("invalidValue", "invalid value for emails: %v", err)
+			}
+			return nil
+		case emailValuePath.MatchString(path):
+			u.Emails = nil
+			if remove {
+				return nil
+			}
+			var mail string
+			if err := decodeString(value, &mail); err != nil {
+				return err
+			}
+			u.Emails = []Email{{Value: mail, Type: "work", Primary: true}}
+			return nil
+		case path == "groups":
+			return badRequest("mutability", "groups are read only, use the members of the group instead")
+		default:
+			return badRequest("invalidPath", "attribute '%s' is unknown or can not be modified", path)
+		}
+	})
+}
+
+// applyGroupPatch applies the patch operations to the group
+func applyGroupPatch(g *Group, ops []PatchOperation) error {
+	return applyPatch(ops, schemaGroup, func(op, path string, value json.RawMessage) error {
+		remove := op == "remove"
+		switch {
+		case path == "displayname":
+			if remove {
+				return badRequest("mutability", "displayName can not be removed")
+			}
+			return decodeString(value, &g.DisplayName)
+		case path == "externalid":
+			g.ExternalID = ""
+			if remove {
+				return nil
+			}
+			return decodeString(value, &g.ExternalID)
+		case path == "members":
+			var members []Reference
+			if len(value) > 0 {
+				if err := json.Unmarshal(value, &members); err != nil {
+					return badRequest("invalidValue", "invalid value for members: %v", err)
+				}
+			}
+			switch {
+			case op == "replace":
+				g.Members = members
+			case op == "add":
+				g.Members = append(g.Members, members...)
+			case len(members) == 0:
+				g.Members = nil
+			default:
+				for _, m := range members {
+					g.removeMember(m.Value)
+				}
+			}
+			return nil
+		case memberValuePath.MatchString(path):
+			if !remove {
+				return badRequest("invalidPath", "members can only be removed by value")
+			}
+			g.removeMember(memberValuePath.FindStringSubmatch(path)[1])
+			return nil
+		default:
+			return badRequest("invalidPath", "attribute '%s' is unknown or can not be modified", path)
+		}
+	})
+}
+
+func (g *Group) removeMember(id string) {
+	members := g.Members[:0]
+	for _, m := range g.Members {
+		if m.Value != id {
+			members = append(members, m)
+		}
+	}
+	g.Members = members
+}
+
+// applyPatch calls apply for every attribute that is modified by the operations. The op and path are lowercased and
+// the schema urn is removed from the path. Operations without a path apply all attributes of the value.
+func applyPatch(ops []PatchOperation, schema string, apply func(op, path string, value json.RawMessage) error) error {
+	prefix := strings.ToLower(schema) + ":"
+	for _, o := range ops {
+		op := strings.ToLower(o.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return badRequest("invalidSyntax", "unknown operation '%s'", o.Op)
+		}
+
+		if o.Path != "" {
+			// keep the case of the values in the path filter, only the attribute names are case insensitive
+			path := o.Path
+			if strings.HasPrefix(strings.ToLower(path), prefix) {
+				path = path[len(prefix):]
+			}
+			if i := strings.IndexByte(path, '['); i >= 0 {
+				path = strings.ToLower(path[:i]) + normalizeValueFilter(path[i:])
+			} else {
+				path = strings.ToLower(path)
+			}
+			if op != "remove" && len(o.Value) == 0 {
+				return badRequest("invalidValue", "operation '%s' on '%s' requires a value", o.Op, o.Path)
+			}
+			if err := apply(op, path, o.Value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if op == "remove" {
+			return badRequest("noTarget", "remove operations require a path")
+		}
+		values := map[string]json.RawMessage{}
+		if err := json.Unmarshal(o.Value, &values); err != nil {
+			return badRequest("invalidValue", "operations without a path require an object value: %v", err)
+		}
+		for attr, value := range values {
+			attr = strings.ToLower(attr)
+			if attr == "schemas" || attr == "id" || attr == "meta" {
+				continue
+			}
+			if err := apply(op, attr, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeValueFilter lowercases the attribute and operator of a simple value filter like [Value Eq "x"].value
+func normalizeValueFilter(filter string) string {
+	end := strings.IndexByte(filter, '"')
+	if end < 0 {
+		return strings.ToLower(filter)
+	}
+	rest := filter[end:]
+	if i := strings.LastIndexByte(rest, '"'); i >= 0 {
+		return strings.ToLower(filter[:end]) + rest[:i+1] + strings.ToLower(rest[i+1:])
+	}
+	return strings.ToLower(filter[:end]) + rest
+}
+
+func decodeString(value json.RawMessage, s *string) error {
+	if err := json.Unmarshal(value, s); err != nil {
+		return badRequest("invalidValue", "expected a string: %v", err)
+	}
+	return nil
+}
+
+// decodeBool accepts booleans as well as their string representation, which some clients send
+func decodeBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(strings.ToLower(s)); err == nil {
+			return b, nil
+		}
+	}
+	return false, badRequest("invalidValue", "expected a boolean")
+}
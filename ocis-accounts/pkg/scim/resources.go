@@ -0,0 +1,216 @@
+package scim
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// Meta contains the resource metadata
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// Name contains the components of a users name, only the formatted name is stored
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// Email is a mail address of a user, only the primary address is stored
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Reference points to a related resource
+type Reference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// User is the scim representation of an account
+type User struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *Name       `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []Email     `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Password    string      `json:"password,omitempty"`
+	Groups      []Reference `json:"groups,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// Group is the scim representation of a group
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// ListResponse is returned when querying resources
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// PatchRequest contains the operations of a PATCH request
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a single add, replace or remove operation
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Error is the scim error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// toUser maps an account to a user, base is the url of the scim endpoint
+func toUser(a *proto.Account, base string) *User {
+	active := a.AccountEnabled
+	u := &User{
+		Schemas:     []string{schemaUser},
+		ID:          a.Id,
+		ExternalID:  a.OnPremisesImmutableId,
+		UserName:    a.PreferredName,
+		DisplayName: a.DisplayName,
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      formatTime(a.CreatedDateTime),
+			LastModified: formatTime(a.LastModifiedDateTime),
+		},
+	}
+	if a.DisplayName != "" {
+		u.Name = &Name{Formatted: a.DisplayName}
+	}
+	if a.Mail != "" {
+		u.Emails = []Email{{Value: a.Mail, Type: "work", Primary: true}}
+	}
+	for _, g := range a.MemberOf {
+		u.Groups = append(u.Groups, Reference{Value: g.Id, Ref: base + "/Groups/" + g.Id, Display: g.DisplayName})
+	}
+	u.Meta.Version = etag(u)
+	u.Meta.Location = base + "/Users/" + a.Id
+	return u
+}
+
+// toAccount maps a user to an account, the groups are read only and ignored
+func (u *User) toAccount() *proto.Account {
+	a := &proto.Account{
+		Id:                       u.ID,
+		AccountEnabled:           u.Active == nil || *u.Active,
+		PreferredName:            u.UserName,
+		OnPremisesSamAccountName: u.UserName,
+		DisplayName:              u.DisplayName,
+		OnPremisesImmutableId:    u.ExternalID,
+		Mail:                     u.primaryEmail(),
+	}
+	if a.DisplayName == "" && u.Name != nil {
+		a.DisplayName = u.Name.Formatted
+		if a.DisplayName == "" {
+			a.DisplayName = strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+		}
+	}
+	if u.Password != "" {
+		a.PasswordProfile = &proto.PasswordProfile{Password: u.Password}
+	}
+	return a
+}
+
+// primaryEmail returns the primary or otherwise the first email
+func (u *User) primaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// toGroup maps a group to its scim representation, base is the url of the scim endpoint
+func toGroup(g *proto.Group, base string) *Group {
+	sg := &Group{
+		Schemas:     []string{schemaGroup},
+		ID:          g.Id,
+		ExternalID:  g.OnPremisesImmutableId,
+		DisplayName: g.DisplayName,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      formatTime(g.CreatedDateTime),
+		},
+	}
+	for _, m := range g.Members {
+		sg.Members = append(sg.Members, Reference{Value: m.Id, Ref: base + "/Users/" + m.Id, Display: m.DisplayName, Type: "User"})
+	}
+	sg.Meta.Version = etag(sg)
+	sg.Meta.Location = base + "/Groups/" + g.Id
+	return sg
+}
+
+// memberIDs returns the ids of the referenced members
+func (g *Group) memberIDs() []string {
+	ids := make([]string, 0, len(g.Members))
+	for _, m := range g.Members {
+		ids = append(ids, m.Value)
+	}
+	return ids
+}
+
+// etag calculates a weak entity tag from the representation of a resource. It has to be called before the
+// location is set, so the tag does not depend on the host used to access the resource.
+func etag(resource interface{}) string {
+	data, _ := json.Marshal(resource)
+	sum := sha256.Sum256(data)
+	return `W/"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+func formatTime(t *timestamp.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,611 @@
+// Package scim implements a SCIM 2.0 provisioning endpoint (RFC 7643, RFC 7644) on top of the accounts and groups
+// services.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	contentType = "application/scim+json"
+	// maxBodySize limits the size of request bodies
+	maxBodySize = 1 << 20
+)
+
+// scimError is an error with the http status and scim error type
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func badRequest(scimType, format string, args ...interface{}) error {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &scimError{status: http.StatusNotFound, detail: fmt.Sprintf(format, args...)}
+}
+
+// fromServiceError converts the errors of the accounts service
+func fromServiceError(err error) *scimError {
+	if e, ok := err.(*scimError); ok {
+		return e
+	}
+	e := merrors.Parse(err.Error())
+	switch e.Code {
+	case 0:
+		return &scimError{status: http.StatusInternalServerError, detail: e.Detail}
+	case http.StatusConflict:
+		return &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: e.Detail}
+	case http.StatusBadRequest:
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: e.Detail}
+	default:
+		return &scimError{status: int(e.Code), detail: e.Detail}
+	}
+}
+
+type handler struct {
+	Options
+}
+
+// NewHandler returns the http handler for the scim endpoint. It has to be mounted on the configured root.
+func NewHandler(opts ...Option) http.Handler {
+	h := handler{Options: newOptions(opts...)}
+
+	r := chi.NewRouter()
+	r.Use(h.authenticate)
+	r.Get("/ServiceProviderConfig", h.serviceProviderConfig)
+	r.Route("/Users", func(r chi.Router) {
+		r.Get("/", h.listUsers)
+		r.Post("/", h.createUser)
+		r.Get("/{id}", h.getUser)
+		r.Put("/{id}", h.replaceUser)
+		r.Patch("/{id}", h.patchUser)
+		r.Delete("/{id}", h.deleteUser)
+	})
+	r.Route("/Groups", func(r chi.Router) {
+		r.Get("/", h.listGroups)
+		r.Post("/", h.createGroup)
+		r.Get("/{id}", h.getGroup)
+		r.Put("/{id}", h.replaceGroup)
+		r.Patch("/{id}", h.patchGroup)
+		r.Delete("/{id}", h.deleteGroup)
+	})
+	return r
+}
+
+// authenticate only lets requests with the configured bearer token pass. The client is trusted like a service, so
+// the requests are authorized without roles.
+func (h handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if h.Token == "" || token == header || subtle.ConstantTimeCompare([]byte(token), []byte(h.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			h.writeError(w, &scimError{status: http.StatusUnauthorized, detail: "invalid bearer token"})
+			return
+		}
+		ctx := auth.NewContext(r.Context(), auth.Principal{Service: "scim"})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	h.write(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": 0},
+		"changePassword": map[string]bool{"supported": true},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": true},
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the configured bearer token",
+			"primary":     true,
+		}},
+	})
+}
+
+func (h handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	query, err := h.query(r, schemaUser, userAttributes)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := &proto.ListAccountsResponse{}
+	if err := h.AccountsService.ListAccounts(r.Context(), &proto.ListAccountsRequest{Query: query}, res); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resources := make([]interface{}, 0, len(res.Accounts))
+	for _, a := range res.Accounts {
+		resources = append(resources, toUser(a, h.base(r)))
+	}
+	h.writeList(w, r, resources)
+}
+
+func (h handler) getUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	u := toUser(a, h.base(r))
+	if match(r.Header.Get("If-None-Match"), u.Meta.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	h.writeResource(w, http.StatusOK, u, u.Meta)
+}
+
+func (h handler) createUser(w http.ResponseWriter, r *http.Request) {
+	u := &User{}
+	if err := decode(r, u); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if u.UserName == "" {
+		h.writeError(w, badRequest("invalidValue", "userName is required"))
+		return
+	}
+	a := u.toAccount()
+	// ids are always assigned by the service
+	a.Id = ""
+
+	out := &proto.Account{}
+	if err := h.AccountsService.CreateAccount(r.Context(), &proto.CreateAccountRequest{Account: a}, out); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	created := toUser(out, h.base(r))
+	h.writeResource(w, http.StatusCreated, created, created.Meta)
+}
+
+func (h handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := checkIfMatch(r, toUser(a, h.base(r)).Meta.Version); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	u := &User{}
+	if err := decode(r, u); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.updateUser(w, r, a.Id, u)
+}
+
+func (h handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	u := toUser(a, h.base(r))
+	if err := checkIfMatch(r, u.Meta.Version); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	patch := &PatchRequest{}
+	if err := decode(r, patch); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := applyUserPatch(u, patch.Operations); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.updateUser(w, r, a.Id, u)
+}
+
+// updateUser replaces all mapped properties of the account with the values of the user
+func (h handler) updateUser(w http.ResponseWriter, r *http.Request, id string, u *User) {
+	if u.UserName == "" {
+		h.writeError(w, badRequest("invalidValue", "userName is required"))
+		return
+	}
+	a := u.toAccount()
+	a.Id = id
+	paths := []string{"AccountEnabled", "DisplayName", "PreferredName", "OnPremisesSamAccountName", "Mail", "OnPremisesImmutableId"}
+	if a.PasswordProfile != nil {
+		paths = append(paths, "PasswordProfile.Password")
+	}
+
+	if err := h.AccountsService.UpdateAccount(r.Context(), &proto.UpdateAccountRequest{
+		Account:    a,
+		UpdateMask: &field_mask.FieldMask{Paths: paths},
+	}, &proto.Account{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	// reload the account, the update does not return the groups
+	updated, err := h.loadAccount(r.Context(), id)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := toUser(updated, h.base(r))
+	h.writeResource(w, http.StatusOK, res, res.Meta)
+}
+
+func (h handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	a, err := h.loadAccount(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := checkIfMatch(r, toUser(a, h.base(r)).Meta.Version); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.AccountsService.DeleteAccount(r.Context(), &proto.DeleteAccountRequest{Id: a.Id}, &empty.Empty{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	query, err := h.query(r, schemaGroup, groupAttributes)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := &proto.ListGroupsResponse{}
+	if err := h.GroupsService.ListGroups(r.Context(), &proto.ListGroupsRequest{Query: query}, res); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resources := make([]interface{}, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		resources = append(resources, toGroup(g, h.base(r)))
+	}
+	h.writeList(w, r, resources)
+}
+
+func (h handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	sg := toGroup(g, h.base(r))
+	if match(r.Header.Get("If-None-Match"), sg.Meta.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	h.writeResource(w, http.StatusOK, sg, sg.Meta)
+}
+
+func (h handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	sg := &Group{}
+	if err := decode(r, sg); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if sg.DisplayName == "" {
+		h.writeError(w, badRequest("invalidValue", "displayName is required"))
+		return
+	}
+	for _, id := range sg.memberIDs() {
+		if _, err := h.loadAccount(r.Context(), id); err != nil {
+			if e := fromServiceError(err); e.status == http.StatusNotFound {
+				err = badRequest("invalidValue", "member %s does not exist", id)
+			}
+			h.writeError(w, err)
+			return
+		}
+	}
+
+	out := &proto.Group{}
+	if err := h.GroupsService.CreateGroup(r.Context(), &proto.CreateGroupRequest{Group: &proto.Group{
+		DisplayName:              sg.DisplayName,
+		OnPremisesSamAccountName: sg.DisplayName,
+		OnPremisesImmutableId:    sg.ExternalID,
+	}}, out); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	// the members are added afterwards, so the memberships of the accounts are maintained
+	h.updateGroup(w, r, out, sg, http.StatusCreated)
+}
+
+func (h handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := checkIfMatch(r, toGroup(g, h.base(r)).Meta.Version); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	sg := &Group{}
+	if err := decode(r, sg); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.updateGroup(w, r, g, sg, http.StatusOK)
+}
+
+func (h handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	sg := toGroup(g, h.base(r))
+	if err := checkIfMatch(r, sg.Meta.Version); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	patch := &PatchRequest{}
+	if err := decode(r, patch); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := applyGroupPatch(sg, patch.Operations); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.updateGroup(w, r, g, sg, http.StatusOK)
+}
+
+// updateGroup changes the group to match the scim representation, members are added and removed one by one
+func (h handler) updateGroup(w http.ResponseWriter, r *http.Request, g *proto.Group, sg *Group, status int) {
+	if sg.DisplayName == "" {
+		h.writeError(w, badRequest("invalidValue", "displayName is required"))
+		return
+	}
+	if sg.DisplayName != g.DisplayName || sg.ExternalID != g.OnPremisesImmutableId {
+		if err := h.GroupsService.UpdateGroup(r.Context(), &proto.UpdateGroupRequest{
+			Group: &proto.Group{
+				Id:                       g.Id,
+				DisplayName:              sg.DisplayName,
+				OnPremisesSamAccountName: sg.DisplayName,
+				OnPremisesImmutableId:    sg.ExternalID,
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName", "OnPremisesSamAccountName", "OnPremisesImmutableId"}},
+		}, &proto.Group{}); err != nil {
+			h.writeError(w, err)
+			return
+		}
+	}
+
+	wanted := map[string]struct{}{}
+	for _, id := range sg.memberIDs() {
+		wanted[id] = struct{}{}
+	}
+	current := map[string]struct{}{}
+	for _, m := range g.Members {
+		current[m.Id] = struct{}{}
+		if _, ok := wanted[m.Id]; ok {
+			continue
+		}
+		if err := h.GroupsService.RemoveMember(r.Context(), &proto.RemoveMemberRequest{GroupId: g.Id, AccountId: m.Id}, &proto.Group{}); err != nil {
+			h.writeError(w, err)
+			return
+		}
+	}
+	for id := range wanted {
+		if _, ok := current[id]; ok {
+			continue
+		}
+		if err := h.GroupsService.AddMember(r.Context(), &proto.AddMemberRequest{GroupId: g.Id, AccountId: id}, &proto.Group{}); err != nil {
+			if e := fromServiceError(err); e.status == http.StatusNotFound {
+				err = badRequest("invalidValue", "member %s does not exist", id)
+			}
+			h.writeError(w, err)
+			return
+		}
+	}
+
+	updated, err := h.loadGroup(r.Context(), g.Id)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	res := toGroup(updated, h.base(r))
+	h.writeResource(w, status, res, res.Meta)
+}
+
+func (h handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.loadGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := checkIfMatch(r, toGroup(g, h.base(r)).Meta.Version); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if err := h.GroupsService.DeleteGroup(r.Context(), &proto.DeleteGroupRequest{Id: g.Id}, &empty.Empty{}); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadAccount returns the account, deleted accounts are not found
+func (h handler) loadAccount(ctx context.Context, id string) (*proto.Account, error) {
+	a := &proto.Account{}
+	if err := h.AccountsService.GetAccount(ctx, &proto.GetAccountRequest{Id: id}, a); err != nil {
+		return nil, err
+	}
+	if a.DeletedDateTime != nil {
+		return nil, notFound("user %s not found", id)
+	}
+	return a, nil
+}
+
+// loadGroup returns the group, deleted groups are not found
+func (h handler) loadGroup(ctx context.Context, id string) (*proto.Group, error) {
+	g := &proto.Group{}
+	if err := h.GroupsService.GetGroup(ctx, &proto.GetGroupRequest{Id: id}, g); err != nil {
+		return nil, err
+	}
+	if g.DeletedDateTime != nil {
+		return nil, notFound("group %s not found", id)
+	}
+	return g, nil
+}
+
+// query translates the filter parameter to an odata query
+func (h handler) query(r *http.Request, schema string, attributes map[string]string) (string, error) {
+	filter := r.URL.Query().Get("filter")
+	if filter == "" {
+		return "", nil
+	}
+	q, err := translateFilter(filter, schema, attributes)
+	if err != nil {
+		return "", badRequest("invalidFilter", "%s", err)
+	}
+	return q, nil
+}
+
+// base returns the url of the scim endpoint, it is used to build the resource locations
+func (h handler) base(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + r.Host + strings.TrimSuffix(h.Root, "/")
+}
+
+// writeList writes the page of resources selected by the startIndex and count parameters
+func (h handler) writeList(w http.ResponseWriter, r *http.Request, resources []interface{}) {
+	start, err := intParam(r, "startIndex", 1)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	count, err := intParam(r, "count", len(resources))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	// the start index is 1-based, values below 1 are interpreted as 1
+	if start < 1 {
+		start = 1
+	}
+	if count < 0 {
+		count = 0
+	}
+
+	page := []interface{}{}
+	if start <= len(resources) {
+		end := start - 1 + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		page = resources[start-1 : end]
+	}
+	h.write(w, http.StatusOK, &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func (h handler) writeResource(w http.ResponseWriter, status int, resource interface{}, meta *Meta) {
+	w.Header().Set("ETag", meta.Version)
+	if status == http.StatusCreated {
+		w.Header().Set("Location", meta.Location)
+	}
+	h.write(w, status, resource)
+}
+
+func (h handler) writeError(w http.ResponseWriter, err error) {
+	e := fromServiceError(err)
+	if e.status >= http.StatusInternalServerError {
+		h.Logger.Error().Err(err).Msg("scim request failed")
+	}
+	h.write(w, e.status, &Error{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(e.status),
+		ScimType: e.scimType,
+		Detail:   e.detail,
+	})
+}
+
+func (h handler) write(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.Logger.Error().Err(err).Msg("could not write scim response")
+	}
+}
+
+// decode reads the json request body
+func decode(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxBodySize)).Decode(v); err != nil {
+		return badRequest("invalidSyntax", "could not parse request body: %v", err)
+	}
+	return nil
+}
+
+func intParam(r *http.Request, name string, def int) (int, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, badRequest("invalidValue", "%s must be an integer", name)
+	}
+	return i, nil
+}
+
+// checkIfMatch returns a precondition error if the If-Match header does not match the current version
+func checkIfMatch(r *http.Request, version string) error {
+	header := r.Header.Get("If-Match")
+	if header == "" || match(header, version) {
+		return nil
+	}
+	return &scimError{status: http.StatusPreconditionFailed, detail: "the resource has been modified"}
+}
+
+// match checks if one of the entity tags in the header matches the version, the weak indicator is ignored
+func match(header, version string) bool {
+	if header == "" {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(version, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/micro/go-micro/v2/client"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dataPath = "/var/tmp/ocis-accounts-scim-tests"
+
+func TestTranslateFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		query  string
+	}{
+		{`userName eq "jdoe"`, "preferred_name eq 'jdoe'"},
+		{`UserName Eq "o'neil"`, "preferred_name eq 'o''neil'"},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jdoe"`, "preferred_name eq 'jdoe'"},
+		{`emails[type eq "work" or value co "@example.org"]`, ""},
+		{`emails[value sw "jdoe"]`, "startswith(mail,'jdoe')"},
+		{`active eq true and not (displayName ew "Doe")`, "(account_enabled eq true and not (endswith(display_name,'Doe')))"},
+		{`externalId eq "a" or (userName ne "b" and meta.lastModified gt "2020-01-01T00:00:00+01:00")`, "(on_premises_immutable_id eq 'a' or (preferred_name ne 'b' and last_modified_date_time gt 2019-12-31T23:00:00Z))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			q, err := translateFilter(tt.filter, schemaUser, userAttributes)
+			if tt.query == "" {
+				// unmapped sub attributes are rejected
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.query, q)
+		})
+	}
+
+	for _, filter := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName pr`,
+		`password eq "secret"`,
+		`userName eq "jdoe" and`,
+		`(userName eq "jdoe"`,
+		`userName eq "jdoe`,
+		`meta.created eq "2020-01-01T00:00:00Z"`,
+		`displayName gt "a"`,
+	} {
+		_, err := translateFilter(filter, schemaUser, userAttributes)
+		assert.Error(t, err, filter)
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	u := &User{UserName: "jdoe", DisplayName: "Jane Doe", Emails: []Email{{Value: "jdoe@example.org", Primary: true}}}
+	err := applyUserPatch(u, []PatchOperation{
+		{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+		{Op: "replace", Path: `emails[type eq "work"].value`, Value: json.RawMessage(`"jane@example.org"`)},
+		{Op: "replace", Value: json.RawMessage(`{"displayName":"Jane Roe","externalId":"ext"}`)},
+		{Op: "remove", Path: "urn:ietf:params:scim:schemas:core:2.0:User:externalId"},
+	})
+	require.NoError(t, err)
+	assert.False(t, *u.Active)
+	assert.Equal(t, "jane@example.org", u.primaryEmail())
+	assert.Equal(t, "Jane Roe", u.DisplayName)
+	assert.Equal(t, "", u.ExternalID)
+
+	assert.Error(t, applyUserPatch(u, []PatchOperation{{Op: "remove", Path: "userName"}}))
+	assert.Error(t, applyUserPatch(u, []PatchOperation{{Op: "add", Path: "groups", Value: json.RawMessage(`[]`)}}))
+	assert.Error(t, applyUserPatch(u, []PatchOperation{{Op: "move", Path: "displayName", Value: json.RawMessage(`"x"`)}}))
+
+	g := &Group{DisplayName: "staff", Members: []Reference{{Value: "a"}, {Value: "b"}}}
+	err = applyGroupPatch(g, []PatchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"c"}]`)},
+		{Op: "Remove", Path: `Members[Value eq "a"]`},
+		{Op: "remove", Path: "members", Value: json.RawMessage(`[{"value":"b"}]`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, g.memberIDs())
+}
+
+func newTestHandler(t *testing.T) http.Handler {
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = dataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+
+	roleService := settings.MockRoleService{
+		AssignRoleToUserFunc: func(ctx context.Context, req *settings.AssignRoleToUserRequest, opts ...client.CallOption) (*settings.AssignRoleToUserResponse, error) {
+			return &settings.AssignRoleToUserResponse{Assignment: &settings.UserRoleAssignment{}}, nil
+		},
+	}
+	s, err := svc.New(svc.Logger(olog.NewLogger()), svc.Config(cfg), svc.RoleService(roleService))
+	require.NoError(t, err)
+
+	return NewHandler(
+		Logger(olog.NewLogger()),
+		Token("secret"),
+		AccountsService(s),
+		GroupsService(s),
+	)
+}
+
+// do sends an authenticated request and decodes the response into v
+func do(t *testing.T, h http.Handler, method, target, body string, header map[string]string, v interface{}) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer secret")
+	for k, val := range header {
+		r.Header.Set(k, val)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if v != nil && w.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), v), w.Body.String())
+	}
+	return w
+}
+
+func TestUsersAndGroups(t *testing.T) {
+	defer os.RemoveAll(dataPath)
+	h := newTestHandler(t)
+
+	// requests without the token are rejected
+	r := httptest.NewRequest(http.MethodGet, "/Users", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	u := &User{}
+	w = do(t, h, http.MethodPost, "/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"userName": "jdoe",
+		"externalId": "4d3f8c1e",
+		"name": {"givenName": "Jane", "familyName": "Doe"},
+		"emails": [{"value": "jdoe@example.org", "type": "work", "primary": true}],
+		"password": "Secret123!"
+	}`, nil, u)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Equal(t, "Jane Doe", u.DisplayName)
+	assert.True(t, *u.Active)
+	assert.Equal(t, "", u.Password)
+	assert.Equal(t, u.Meta.Location, w.Header().Get("Location"))
+	assert.Equal(t, u.Meta.Version, w.Header().Get("ETag"))
+
+	e := &Error{}
+	w = do(t, h, http.MethodPost, "/Users", `{"userName": "JDoe", "emails": [{"value": "other@example.org"}]}`, nil, e)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "uniqueness", e.ScimType)
+
+	list := &ListResponse{}
+	w = do(t, h, http.MethodGet, `/Users?filter=`+url.QueryEscape(`userName eq "jdoe"`), "", nil, list)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, 1, list.TotalResults)
+
+	w = do(t, h, http.MethodGet, `/Users?filter=`+url.QueryEscape(`userName eq`), "", nil, e)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "invalidFilter", e.ScimType)
+
+	w = do(t, h, http.MethodGet, "/Users/"+u.ID, "", map[string]string{"If-None-Match": u.Meta.Version}, nil)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	patch := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"active","value":"False"}]}`
+	w = do(t, h, http.MethodPatch, "/Users/"+u.ID, patch, map[string]string{"If-Match": `W/"outdated"`}, nil)
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+	patched := &User{}
+	w = do(t, h, http.MethodPatch, "/Users/"+u.ID, patch, map[string]string{"If-Match": u.Meta.Version}, patched)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.False(t, *patched.Active)
+	assert.NotEqual(t, u.Meta.Version, patched.Meta.Version)
+
+	g := &Group{}
+	w = do(t, h, http.MethodPost, "/Groups", `{"displayName": "scim-staff", "members": [{"value": "`+u.ID+`"}]}`, nil, g)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	require.Len(t, g.Members, 1)
+	assert.Equal(t, u.ID, g.Members[0].Value)
+
+	w = do(t, h, http.MethodGet, "/Users/"+u.ID, "", nil, u)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, u.Groups, 1)
+	assert.Equal(t, g.ID, u.Groups[0].Value)
+
+	w = do(t, h, http.MethodPost, "/Groups", `{"displayName": "scim-invalid", "members": [{"value": "does-not-exist"}]}`, nil, e)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = do(t, h, http.MethodPatch, "/Groups/"+g.ID, `{"Operations":[{"op":"remove","path":"members[value eq \"`+u.ID+`\"]"},{"op":"replace","path":"displayName","value":"scim-team"}]}`, nil, g)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Len(t, g.Members, 0)
+	assert.Equal(t, "scim-team", g.DisplayName)
+
+	w = do(t, h, http.MethodDelete, "/Users/"+u.ID, "", nil, nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	w = do(t, h, http.MethodGet, "/Users/"+u.ID, "", nil, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,40 @@
+package debug
+
+import (
+	"sort"
+	"sync"
+)
+
+// Readiness tracks the conditions that have to be met before the service is ready to handle requests
+type Readiness struct {
+	mu      sync.Mutex
+	pending map[string]struct{}
+}
+
+// NewReadiness returns a readiness that is pending until all conditions are done
+func NewReadiness(conditions ...string) *Readiness {
+	r := &Readiness{pending: map[string]struct{}{}}
+	for _, c := range conditions {
+		r.pending[c] = struct{}{}
+	}
+	return r
+}
+
+// Done marks a condition as met
+func (r *Readiness) Done(condition string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.pending, condition)
+}
+
+// Pending returns the sorted conditions that are not met yet
+func (r *Readiness) Pending() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pending := make([]string, 0, len(r.pending))
+	for c := range r.pending {
+		pending = append(pending, c)
+	}
+	sort.Strings(pending)
+	return pending
+}
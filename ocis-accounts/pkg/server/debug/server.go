@@ -0,0 +1,63 @@
+package debug
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Server initializes the debug server. It serves the health, readiness and metrics endpoints and optionally pprof on
+// a separate listener, so they are not exposed together with the api.
+func Server(opts ...Option) (*http.Server, error) {
+	options := newOptions(opts...)
+
+	if options.Config.Debug.Token == "" {
+		options.Logger.Warn().Msg("no debug token configured, debug endpoints are served without authentication")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, "OK\n")
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		if options.Readiness != nil {
+			if pending := options.Readiness.Pending(); len(pending) > 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = io.WriteString(w, "waiting for "+strings.Join(pending, ", ")+"\n")
+				return
+			}
+		}
+		_, _ = io.WriteString(w, "OK\n")
+	})
+	mux.Handle("/metrics", promhttp.Handler())
+
+	if options.Config.Debug.Pprof {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+
+	return &http.Server{
+		Addr:    options.Config.Debug.Addr,
+		Handler: token(options.Config.Debug.Token, mux),
+	}, nil
+}
+
+// token only grants access to requests with the bearer token, if one is configured
+func token(t string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+t)) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
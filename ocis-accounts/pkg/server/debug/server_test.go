@@ -0,0 +1,58 @@
+package debug
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, server *http.Server, target, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	server.Handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestToken(t *testing.T) {
+	cfg := config.New()
+	cfg.Debug.Token = "secret"
+	server, err := Server(Logger(olog.NewLogger()), Config(cfg))
+	require.NoError(t, err)
+
+	for _, target := range []string{"/healthz", "/readyz", "/metrics"} {
+		assert.Equal(t, http.StatusUnauthorized, get(t, server, target, "").Code, target)
+		assert.Equal(t, http.StatusUnauthorized, get(t, server, target, "wrong").Code, target)
+		assert.Equal(t, http.StatusOK, get(t, server, target, "secret").Code, target)
+	}
+	assert.Equal(t, http.StatusNotFound, get(t, server, "/debug/pprof/", "secret").Code, "pprof is disabled by default")
+
+	cfg.Debug.Pprof = true
+	server, err = Server(Logger(olog.NewLogger()), Config(cfg))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, get(t, server, "/debug/pprof/", "secret").Code)
+}
+
+func TestReadiness(t *testing.T) {
+	readiness := NewReadiness("index", "settings")
+	server, err := Server(Logger(olog.NewLogger()), Config(config.New()), Ready(readiness))
+	require.NoError(t, err)
+
+	w := get(t, server, "/readyz", "")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "waiting for index, settings\n", w.Body.String())
+	assert.Equal(t, http.StatusOK, get(t, server, "/healthz", "").Code)
+
+	readiness.Done("index")
+	readiness.Done("settings")
+	w = get(t, server, "/readyz", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "OK\n", w.Body.String())
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/server"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/metrics"
+)
+
+// metricsWrapper records the count, duration and status code of the handled requests
+func metricsWrapper(m *metrics.Metrics) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			start := time.Now()
+			err := fn(ctx, req, rsp)
+			m.ObserveRequest("grpc", req.Endpoint(), statusCode(err), start)
+			return err
+		}
+	}
+}
+
+// statusCode returns the http status code of a go-micro error
+func statusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	if code := int(merrors.Parse(err.Error()).Code); code != 0 {
+		return code
+	}
+	return http.StatusInternalServerError
+}
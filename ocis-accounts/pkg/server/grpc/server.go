@@ -1,7 +1,10 @@
 package grpc
 
 import (
+	"github.com/micro/go-micro/v2/server"
 	"github.com/owncloud/ocis-pkg/v2/service/grpc"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/ldap"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
 	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
 )
@@ -22,7 +25,27 @@ func Server(opts ...Option) grpc.Service {
 	var hdlr *svc.Service
 	var err error
 
-	if hdlr, err = svc.New(svc.Logger(options.Logger), svc.Config(options.Config)); err != nil {
+	if options.Config.GRPC.AllowUnauthenticated {
+		options.Logger.Warn().Msg("grpc requests without a service token are trusted, disable grpc-allow-unauthenticated once all services send one")
+	}
+	if err = service.Server().Init(
+		server.WrapHandler(metricsWrapper(options.Metrics)),
+		server.WrapHandler(tracingWrapper()),
+		server.WrapHandler(auth.HandlerWrapper(options.Config.TokenManager.JWTSecret, options.Config.GRPC.AllowUnauthenticated)),
+	); err != nil {
+		options.Logger.Fatal().Err(err).Msg("could not initialize server")
+	}
+
+	// the broker of the server is connected when the service starts. The roles are needed to authorize the requests
+	// services make on behalf of users.
+	if hdlr, err = svc.New(
+		svc.Logger(options.Logger),
+		svc.Config(options.Config),
+		svc.RoleManager(options.RoleManager),
+		svc.RoleService(options.RoleService),
+		svc.Broker(service.Server().Options().Broker),
+		svc.Metrics(options.Metrics),
+	); err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not initialize service handler")
 	}
 	if err = proto.RegisterAccountsServiceHandler(service.Server(), hdlr); err != nil {
@@ -32,6 +55,28 @@ func Server(opts ...Option) grpc.Service {
 		options.Logger.Fatal().Err(err).Msg("could not register groups handler")
 	}
 
+	// purge deleted accounts and groups after their retention period
+	go hdlr.RunPurge(options.Context)
+	go hdlr.RunSync(options.Context)
+	go hdlr.RunSchedule(options.Context)
+
+	if cfg := options.Config.LDAPServer; cfg.Addr != "" {
+		// the ldap server shares the handler, so it sees the same index as the grpc api
+		server := ldap.NewServer(
+			ldap.Logger(options.Logger),
+			ldap.Addr(cfg.Addr),
+			ldap.BaseDN(cfg.BaseDN),
+			ldap.TLS(cfg.CertFile, cfg.KeyFile),
+			ldap.AccountsService(hdlr),
+			ldap.GroupsService(hdlr),
+		)
+		go func() {
+			if err := server.Run(options.Context); err != nil {
+				options.Logger.Fatal().Err(err).Msg("could not run ldap server")
+			}
+		}()
+	}
+
 	service.Init()
 	return service
 }
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/v2/server"
+	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
+	"go.opencensus.io/plugin/ochttp"
+	"go.opencensus.io/trace"
+)
+
+// tracingWrapper starts a server span for every handled request. It continues the trace of the caller if the request
+// metadata carries a span context.
+func tracingWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			var span *trace.Span
+			name := "grpc." + req.Endpoint()
+			if parent, ok := svc.TraceContextFromMetadata(ctx); ok {
+				ctx, span = trace.StartSpanWithRemoteParent(ctx, name, parent, trace.WithSpanKind(trace.SpanKindServer))
+			} else {
+				ctx, span = trace.StartSpan(ctx, name, trace.WithSpanKind(trace.SpanKindServer))
+			}
+			defer span.End()
+
+			span.AddAttributes(trace.StringAttribute("service", req.Service()))
+			err := fn(ctx, req, rsp)
+			if err != nil {
+				span.SetStatus(ochttp.TraceStatus(statusCode(err), err.Error()))
+			}
+			return err
+		}
+	}
+}
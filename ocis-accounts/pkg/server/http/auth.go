@@ -0,0 +1,36 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/owncloud/ocis-pkg/v2/roles"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+)
+
+// authenticate turns the account of a verified access token into the principal of the request. It has to run after
+// the ExtractAccountUUID middleware, which only passes the account and its roles on for valid tokens.
+func authenticate(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		ctx := r.Context()
+		if accountID, ok := metadata.Get(ctx, middleware.AccountID); ok && accountID != "" {
+			p := auth.Principal{AccountID: accountID}
+			p.RoleIDs, _ = roles.ReadRoleIDsFromContext(ctx)
+			ctx = auth.NewContext(ctx, p)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requireAuthentication rejects requests without a principal. The generated web handlers would answer them with a
+// bad request.
+func requireAuthentication(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if _, ok := auth.FromContext(r.Context()); !ok {
+			nethttp.Error(w, nethttp.StatusText(nethttp.StatusUnauthorized), nethttp.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+// accountsStub answers list requests, the other methods are not called
+type accountsStub struct {
+	proto.AccountsServiceHandler
+	principal auth.Principal
+}
+
+func (a *accountsStub) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest, out *proto.ListAccountsResponse) error {
+	a.principal, _ = auth.FromContext(ctx)
+	return nil
+}
+
+func TestAuthentication(t *testing.T) {
+	stub := &accountsStub{}
+	mux := chi.NewMux()
+	mux.Use(authenticate)
+	mux.Route("/", func(r chi.Router) {
+		r.Use(requireAuthentication)
+		proto.RegisterAccountsServiceWeb(r, stub)
+	})
+
+	list := func(ctx context.Context) int {
+		r := httptest.NewRequest(http.MethodPost, "/api/v0/accounts/accounts-list", strings.NewReader("{}")).WithContext(ctx)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, list(context.Background()))
+	assert.Equal(t, http.StatusUnauthorized, list(metadata.Set(context.Background(), middleware.AccountID, "")))
+	assert.Equal(t, auth.Principal{}, stub.principal, "the service must not be called")
+
+	ctx := metadata.Set(context.Background(), middleware.AccountID, "4c510ada-c86b-4815-8820-42cdf82c3d51")
+	ctx = metadata.Set(ctx, middleware.RoleIDs, `["71881883-1768-46bd-a24d-a356a2afdf7f"]`)
+	assert.Equal(t, http.StatusOK, list(ctx))
+	assert.Equal(t, "4c510ada-c86b-4815-8820-42cdf82c3d51", stub.principal.AccountID)
+	assert.Equal(t, []string{"71881883-1768-46bd-a24d-a356a2afdf7f"}, stub.principal.RoleIDs)
+	assert.Empty(t, stub.principal.Service)
+}
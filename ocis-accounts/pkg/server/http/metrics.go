@@ -0,0 +1,32 @@
+package http
+
+import (
+	nethttp "net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	chimiddleware "github.com/go-chi/chi/middleware"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/metrics"
+)
+
+// instrument records the count, duration and status code of the handled requests by route pattern
+func instrument(m *metrics.Metrics) func(nethttp.Handler) nethttp.Handler {
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			start := time.Now()
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			// the pattern is only known after routing, using it keeps the cardinality of the method label bounded
+			pattern := "unmatched"
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				pattern = rctx.RoutePattern()
+			}
+			code := ww.Status()
+			if code == 0 {
+				code = nethttp.StatusOK
+			}
+			m.ObserveRequest("http", pattern, code, start)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/micro/cli/v2"
 	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/owncloud/ocis-pkg/v2/roles"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/metrics"
 )
@@ -20,6 +22,9 @@ type Options struct {
 	Config  *config.Config
 	Metrics *metrics.Metrics
 	Flags   []cli.Flag
+
+	RoleService settings.RoleService
+	RoleManager *roles.Manager
 }
 
 // newOptions initializes the available default options.
@@ -74,3 +79,17 @@ func Flags(val []cli.Flag) Option {
 		o.Flags = append(o.Flags, val...)
 	}
 }
+
+// RoleService provides a function to set the role service option.
+func RoleService(val settings.RoleService) Option {
+	return func(o *Options) {
+		o.RoleService = val
+	}
+}
+
+// RoleManager provides a function to set the role manager option.
+func RoleManager(val *roles.Manager) Option {
+	return func(o *Options) {
+		o.RoleManager = val
+	}
+}
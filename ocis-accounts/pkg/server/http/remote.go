@@ -0,0 +1,17 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"github.com/micro/go-micro/v2/metadata"
+	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
+)
+
+// remoteAddr passes the client address to the service handler like the go-micro grpc server does, it is recorded in
+// the audit log. It has to run after the RealIP middleware.
+func remoteAddr(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		ctx := metadata.Set(r.Context(), svc.RemoteMetadataKey, r.RemoteAddr)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
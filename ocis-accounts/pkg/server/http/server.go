@@ -1,17 +1,17 @@
 package http
 
 import (
-	"time"
+	"path"
 
 	"github.com/go-chi/chi"
-	mclient "github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-micro/v2/broker"
 	"github.com/owncloud/ocis-pkg/v2/account"
 	"github.com/owncloud/ocis-pkg/v2/middleware"
-	"github.com/owncloud/ocis-pkg/v2/roles"
 	"github.com/owncloud/ocis-pkg/v2/service/http"
-	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/assets"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/graph"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/scim"
 	svc "github.com/refs/ocis-mono/ocis-accounts/pkg/service/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/version"
 )
@@ -30,20 +30,17 @@ func Server(opts ...Option) http.Service {
 		http.Flags(options.Flags...),
 	)
 
-	// TODO this won't work with a registry other than mdns. Look into Micro's client initialization.
-	// https://github.com/owncloud/ocis-proxy/issues/38
-	rs := settings.NewRoleService("com.owncloud.api.settings", mclient.DefaultClient)
-	roleManager := roles.NewManager(
-		roles.CacheSize(1024),
-		roles.CacheTTL(time.Hour*24*7),
-		roles.Logger(options.Logger),
-		roles.RoleService(rs),
-	)
+	// the web service has no broker of its own, mutations are published through the default broker
+	if err := broker.Connect(); err != nil {
+		options.Logger.Error().Err(err).Msg("could not connect broker, events will not be published")
+	}
 	handler, err := svc.New(
 		svc.Logger(options.Logger),
 		svc.Config(options.Config),
-		svc.RoleManager(&roleManager),
-		svc.RoleService(rs),
+		svc.RoleManager(options.RoleManager),
+		svc.RoleService(options.RoleService),
+		svc.Broker(broker.DefaultBroker),
+		svc.Metrics(options.Metrics),
 	)
 	if err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not initialize service handler")
@@ -52,6 +49,9 @@ func Server(opts ...Option) http.Service {
 	mux := chi.NewMux()
 
 	mux.Use(middleware.RealIP)
+	mux.Use(remoteAddr)
+	mux.Use(instrument(options.Metrics))
+	mux.Use(tracing)
 	mux.Use(middleware.RequestID)
 	mux.Use(middleware.Cache)
 	mux.Use(middleware.Cors)
@@ -60,6 +60,7 @@ func Server(opts ...Option) http.Service {
 		account.Logger(options.Logger),
 		account.JWTSecret(options.Config.TokenManager.JWTSecret)),
 	)
+	mux.Use(authenticate)
 
 	mux.Use(middleware.Version(
 		options.Name,
@@ -70,17 +71,43 @@ func Server(opts ...Option) http.Service {
 		options.Logger,
 	))
 
-	mux.Use(middleware.Static(
-		options.Config.HTTP.Root,
-		assets.New(
-			assets.Logger(options.Logger),
-			assets.Config(options.Config),
-		),
+	if options.Config.SCIM.Token != "" {
+		// the scim endpoint authenticates with its own bearer token and does not serve the static assets
+		root := path.Join(options.Config.HTTP.Root, "scim", "v2")
+		mux.Mount(root, scim.NewHandler(
+			scim.Logger(options.Logger),
+			scim.Root(root),
+			scim.Token(options.Config.SCIM.Token),
+			scim.AccountsService(handler),
+			scim.GroupsService(handler),
+		))
+	} else {
+		options.Logger.Info().Msg("scim endpoint is disabled, no token configured")
+	}
+
+	// the graph endpoint is authorized by the service handler, like the rpc api
+	graphRoot := path.Join(options.Config.HTTP.Root, "v1.0")
+	mux.With(requireAuthentication).Mount(graphRoot, graph.NewHandler(
+		graph.Logger(options.Logger),
+		graph.Root(graphRoot),
+		graph.AccountsService(handler),
+		graph.GroupsService(handler),
 	))
 
-	mux.Route(options.Config.HTTP.Root, func(r chi.Router) {
-		proto.RegisterAccountsServiceWeb(r, handler)
-		proto.RegisterGroupsServiceWeb(r, handler)
+	mux.Group(func(r chi.Router) {
+		r.Use(middleware.Static(
+			options.Config.HTTP.Root,
+			assets.New(
+				assets.Logger(options.Logger),
+				assets.Config(options.Config),
+			),
+		))
+
+		r.Route(options.Config.HTTP.Root, func(r chi.Router) {
+			r.Use(requireAuthentication)
+			proto.RegisterAccountsServiceWeb(r, handler)
+			proto.RegisterGroupsServiceWeb(r, handler)
+		})
 	})
 
 	service.Handle(
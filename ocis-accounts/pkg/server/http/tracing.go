@@ -0,0 +1,15 @@
+package http
+
+import (
+	nethttp "net/http"
+
+	"go.opencensus.io/plugin/ochttp"
+)
+
+// tracing starts a server span for every handled request. It continues the trace of the caller if the request carries
+// b3 propagation headers, the span context is passed to the service handler in the request context.
+func tracing(next nethttp.Handler) nethttp.Handler {
+	return &ochttp.Handler{
+		Handler: next,
+	}
+}
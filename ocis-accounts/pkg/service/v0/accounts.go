@@ -8,22 +8,24 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/CiscoM31/godata"
 	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
 	fieldmask_utils "github.com/mennanov/fieldmask-utils"
 	merrors "github.com/micro/go-micro/v2/errors"
-	"github.com/owncloud/ocis-pkg/v2/roles"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	settings_svc "github.com/owncloud/ocis-settings/pkg/service/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/provider"
 	"github.com/rs/zerolog"
 	"github.com/tredoe/osutil/user/crypt"
+	"go.opencensus.io/trace"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -37,7 +39,7 @@ import (
 // accLock mutually exclude readers from writers on account files
 var accLock sync.Mutex
 
-func (s Service) indexAccounts(path string) (err error) {
+func (s Service) indexAccounts(ctx context.Context, path string) (err error) {
 	var f *os.File
 	if f, err = os.Open(path); err != nil {
 		s.log.Error().Err(err).Str("dir", path).Msg("could not open accounts folder")
@@ -50,7 +52,7 @@ func (s Service) indexAccounts(path string) (err error) {
 		return
 	}
 	for _, file := range list {
-		err = s.indexAccount(file.Name())
+		err = s.indexAccount(ctx, file.Name())
 		if err != nil {
 			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not index account")
 		}
@@ -59,14 +61,21 @@ func (s Service) indexAccounts(path string) (err error) {
 	return
 }
 
-func (s Service) indexAccount(id string) error {
+func (s Service) indexAccount(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "index.index", trace.StringAttribute("account_id", id))
+	defer func() { endSpan(span, err) }()
 	a := &proto.BleveAccount{
 		BleveType: "account",
 	}
-	if err := s.loadAccount(id, &a.Account); err != nil {
+	if err := s.loadAccount(ctx, id, &a.Account); err != nil {
 		s.log.Error().Err(err).Str("account", id).Msg("could not load account")
 		return err
 	}
+	for _, i := range a.Identities {
+		if i.Issuer != "" && i.IssuerAssignedId != "" {
+			a.IdentityKeys = append(a.IdentityKeys, identityKey(i.Issuer, i.IssuerAssignedId))
+		}
+	}
 	s.log.Debug().Interface("account", a).Msg("found account")
 	if err := s.index.Index(a.Id, a); err != nil {
 		s.log.Error().Err(err).Interface("account", a).Msg("could not index account")
@@ -79,7 +88,10 @@ func (s Service) indexAccount(id string) error {
 // login eq \"teddy\" and password eq \"F&1!b90t111!\"
 var authQuery = regexp.MustCompile(`^login eq '(.*)' and password eq '(.*)'$`) // TODO how is ' escaped in the password?
 
-func (s Service) loadAccount(id string, a *proto.Account) (err error) {
+func (s Service) loadAccount(ctx context.Context, id string, a *proto.Account) (err error) {
+	_, span := startSpan(ctx, "storage.read", trace.StringAttribute("type", "account"), trace.StringAttribute("account_id", id))
+	defer func() { endSpan(span, err) }()
+	defer s.metrics.ObserveStorage("read", "account", time.Now())
 	path := filepath.Join(s.Config.Server.AccountsDataPath, "accounts", id)
 
 	var data []byte
@@ -93,7 +105,10 @@ func (s Service) loadAccount(id string, a *proto.Account) (err error) {
 	return
 }
 
-func (s Service) writeAccount(a *proto.Account) (err error) {
+func (s Service) writeAccount(ctx context.Context, a *proto.Account) (err error) {
+	_, span := startSpan(ctx, "storage.write", trace.StringAttribute("type", "account"), trace.StringAttribute("account_id", a.Id))
+	defer func() { endSpan(span, err) }()
+	defer s.metrics.ObserveStorage("write", "account", time.Now())
 	// leave only the group id
 	s.deflateMemberOf(a)
 
@@ -110,15 +125,16 @@ func (s Service) writeAccount(a *proto.Account) (err error) {
 	return
 }
 
-func (s Service) expandMemberOf(a *proto.Account) {
+func (s Service) expandMemberOf(ctx context.Context, a *proto.Account) {
 	if a == nil {
 		return
 	}
+	ctx, span := startSpan(ctx, "expandMemberOf", trace.StringAttribute("account_id", a.Id), trace.Int64Attribute("groups", int64(len(a.MemberOf))))
+	defer span.End()
 	expanded := []*proto.Group{}
 	for i := range a.MemberOf {
 		g := &proto.Group{}
-		// TODO resolve by name, when a create or update is issued they may not have an id? fall back to searching the group id in the index?
-		if err := s.loadGroup(a.MemberOf[i].Id, g); err == nil {
+		if err := s.loadGroup(ctx, a.MemberOf[i].Id, g); err == nil {
 			g.Members = nil // always hide members when expanding
 			expanded = append(expanded, g)
 		} else {
@@ -139,8 +155,8 @@ func (s Service) deflateMemberOf(a *proto.Account) {
 		if a.MemberOf[i].Id != "" {
 			deflated = append(deflated, &proto.Group{Id: a.MemberOf[i].Id})
 		} else {
-			// TODO fetch and use an id when group only has a name but no id
-			s.log.Error().Str("id", a.Id).Interface("group", a.MemberOf[i]).Msg("resolving groups by name is not implemented yet")
+			// references by name are resolved with resolveGroups before an account is persisted
+			s.log.Error().Str("id", a.Id).Interface("group", a.MemberOf[i]).Msg("dropping group reference without id")
 		}
 	}
 	a.MemberOf = deflated
@@ -157,42 +173,48 @@ func (s Service) passwordIsValid(hash string, pwd string) (ok bool) {
 	return c.Verify(hash, []byte(pwd)) == nil
 }
 
-func (s Service) hasAccountManagementPermissions(ctx context.Context) bool {
-	// get roles from context
-	roleIDs, ok := roles.ReadRoleIDsFromContext(ctx)
-	if !ok {
-		/**
-		 * FIXME: with this we are skipping permission checks on all requests that are coming in without roleIDs in the
-		 * metadata context. This is a huge security impairment, as that's the case not only for grpc requests but also
-		 * for unauthenticated http requests and http requests coming in without hitting the ocis-proxy first.
-		 */
-		return true
-	}
-
-	// check if permission is present in roles of the authenticated account
-	return s.RoleManager.FindPermissionByID(ctx, roleIDs, AccountManagementPermissionID) != nil
-}
-
 // ListAccounts implements the AccountsServiceHandler interface
 // the query contains account properties
 func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest, out *proto.ListAccountsResponse) (err error) {
-	if !s.hasAccountManagementPermissions(ctx) {
-		return merrors.Forbidden(s.id, "no permission for ListAccounts")
+	ctx, span := startSpan(ctx, "AccountsService.ListAccounts")
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "ListAccounts", (*access).mayReadAccounts); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		if err = s.listLDAPAccounts(in, out); err != nil {
+			return
+		}
+		readable := make([]*proto.Account, 0, len(out.Accounts))
+		for _, a := range out.Accounts {
+			if acl.canReadAccount(a) {
+				readable = append(readable, a)
+			}
+		}
+		out.Accounts = readable
+		return
 	}
 
 	accLock.Lock()
 	defer accLock.Unlock()
-	var password string
+	var login, password string
 
 	// check if this looks like an auth request
 	match := authQuery.FindStringSubmatch(in.Query)
 	if len(match) == 3 {
-		in.Query = fmt.Sprintf("on_premises_sam_account_name eq '%s'", match[1]) // todo fetch email? make query configurable
+		login = match[1]
+		in.Query = fmt.Sprintf("on_premises_sam_account_name eq '%s'", login) // todo fetch email? make query configurable
 		password = match[2]
 		if password == "" {
+			s.metrics.ObserveAuthentication(false)
 			return merrors.Unauthorized(s.id, "password must not be empty")
 		}
 	}
+	// the rewritten query of an auth request no longer contains the password
+	span.AddAttributes(trace.StringAttribute("query", in.Query), trace.BoolAttribute("authentication", password != ""))
 
 	// only search for accounts
 	tq := bleve.NewTermQuery("account")
@@ -217,11 +239,23 @@ func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest
 		query.AddQuery(bq)
 	}
 
-	s.log.Debug().Interface("query", query).Msg("using query")
+	var sq bquery.Query = query
+	if !in.ShowDeleted {
+		sq = excludeDeleted(query)
+	}
+	// service accounts and guests are hidden unless they are requested, authentication requests find both
+	if !in.ShowServiceAccounts && password == "" {
+		sq = excludeServiceAccounts(sq)
+	}
+	if !in.ShowGuests && password == "" {
+		sq = excludeGuests(sq)
+	}
 
-	searchRequest := bleve.NewSearchRequest(query)
+	s.log.Debug().Interface("query", sq).Msg("using query")
+
+	searchRequest := bleve.NewSearchRequest(sq)
 	var searchResult *bleve.SearchResult
-	searchResult, err = s.index.Search(searchRequest)
+	searchResult, err = s.search(ctx, searchRequest)
 	if err != nil {
 		s.log.Error().Err(err).Msg("could not execute bleve search")
 		return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
@@ -233,10 +267,13 @@ func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest
 
 	for _, hit := range searchResult.Hits {
 		a := &proto.Account{}
-		if err = s.loadAccount(hit.ID, a); err != nil {
+		if err = s.loadAccount(ctx, hit.ID, a); err != nil {
 			s.log.Error().Err(err).Str("account", hit.ID).Msg("could not load account, skipping")
 			continue
 		}
+		if !acl.canReadAccount(a) {
+			continue
+		}
 		var currentHash string
 		if a.PasswordProfile != nil {
 			currentHash = a.PasswordProfile.Password
@@ -245,33 +282,72 @@ func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest
 		s.debugLogAccount(a).Msg("found account")
 
 		if password != "" {
+			// the match query also finds accounts that only share some terms with the login
+			if !strings.EqualFold(a.OnPremisesSamAccountName, login) {
+				continue
+			}
 			if a.PasswordProfile == nil {
 				s.debugLogAccount(a).Msg("no password profile")
+				s.metrics.ObserveAuthentication(false)
 				return merrors.Unauthorized(s.id, "invalid password")
 			}
-			if !s.passwordIsValid(currentHash, password) {
+			// disabled accounts are refused like a wrong password, as api keys of disabled service accounts are
+			if !s.passwordIsValid(currentHash, password) || !a.AccountEnabled {
+				s.metrics.ObserveAuthentication(false)
 				return merrors.Unauthorized(s.id, "invalid password")
 			}
+			// the scheduler disables expired accounts periodically, until then they are refused here
+			if expired(a.ExpirationDateTime, time.Now()) {
+				s.metrics.ObserveAuthentication(false)
+				return merrors.Unauthorized(s.id, "account has expired")
+			}
+			s.metrics.ObserveAuthentication(true)
+
+			// remember the sign-in, failing to do so must not prevent it
+			a.LastSignInDateTime = timestamppb.Now()
+			if err := s.writeAccount(ctx, a); err != nil {
+				s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist last sign-in")
+			} else if err := s.indexAccount(ctx, a.Id); err != nil {
+				s.log.Error().Err(err).Str("id", a.Id).Msg("could not index last sign-in")
+			}
 		}
 		// TODO add groups if requested
 		// if in.FieldMask ...
-		s.expandMemberOf(a)
+		s.expandMemberOf(ctx, a)
 
-		// remove password before returning
-		if a.PasswordProfile != nil {
-			a.PasswordProfile.Password = ""
-		}
+		// remove password and api key hashes before returning
+		hideSecrets(a)
 
 		out.Accounts = append(out.Accounts, a)
 	}
 
+	if password != "" && len(out.Accounts) == 0 {
+		// unknown login
+		s.metrics.ObserveAuthentication(false)
+	}
+
 	return
 }
 
 // GetAccount implements the AccountsServiceHandler interface
 func (s Service) GetAccount(ctx context.Context, in *proto.GetAccountRequest, out *proto.Account) (err error) {
-	if !s.hasAccountManagementPermissions(ctx) {
-		return merrors.Forbidden(s.id, "no permission for GetAccount")
+	ctx, span := startSpan(ctx, "AccountsService.GetAccount", trace.StringAttribute("account_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "GetAccount", func(a *access) bool { return a.mayReadAccounts() || a.hasAccount() }); err != nil {
+		return
+	}
+	// users get their own account without knowing its id
+	if in.Id == "" {
+		in.Id = acl.accountID
+	}
+
+	if s.ldap != nil {
+		if err = s.getLDAPAccount(in, out); err == nil && !acl.canReadAccount(out) {
+			return s.forbidden("GetAccount")
+		}
+		return
 	}
 
 	accLock.Lock()
@@ -281,29 +357,38 @@ func (s Service) GetAccount(ctx context.Context, in *proto.GetAccountRequest, ou
 		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
 	}
 
-	if err = s.loadAccount(id, out); err != nil {
+	if err = s.loadAccount(ctx, id, out); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
 		return
 	}
+	if !acl.canReadAccount(out) {
+		return s.forbidden("GetAccount")
+	}
 
 	s.debugLogAccount(out).Msg("found account")
 
 	// TODO add groups if requested
 	// if in.FieldMask ...
-	s.expandMemberOf(out)
+	s.expandMemberOf(ctx, out)
 
-	// remove password
-	if out.PasswordProfile != nil {
-		out.PasswordProfile.Password = ""
-	}
+	// remove password and api key hashes
+	hideSecrets(out)
 
 	return
 }
 
 // CreateAccount implements the AccountsServiceHandler interface
 func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountRequest, out *proto.Account) (err error) {
-	if !s.hasAccountManagementPermissions(ctx) {
-		return merrors.Forbidden(s.id, "no permission for CreateAccount")
+	ctx, span := startSpan(ctx, "AccountsService.CreateAccount", trace.StringAttribute("account_id", in.GetAccount().GetId()))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "CreateAccount", (*access).mayManageAccounts); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
 	}
 
 	accLock.Lock()
@@ -322,11 +407,51 @@ func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountReque
 	if !s.isValidEmail(acc.Mail) {
 		return merrors.BadRequest(s.id, "mail '%s' must be a valid email", acc.Mail)
 	}
+	if acc.UserType != "" && acc.UserType != userTypeMember && acc.UserType != userTypeGuest {
+		return merrors.BadRequest(s.id, "user_type must be '%s' or '%s'", userTypeMember, userTypeGuest)
+	}
+	if acc.AccountEnabled && expired(acc.ExpirationDateTime, time.Now()) {
+		return merrors.BadRequest(s.id, "expired accounts can not be enabled")
+	}
 
 	if id, err = cleanupID(acc.Id); err != nil {
 		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
 	}
 
+	// group references may use the id or the on_premises_sam_account_name, memberships are added after persisting
+	var memberOf []*proto.Group
+	if memberOf, err = s.resolveGroups(ctx, acc.MemberOf); err != nil {
+		return
+	}
+	acc.MemberOf = nil
+	// scoped administrators have to create accounts in their scope
+	if err = s.checkMemberships(ctx, acl, "CreateAccount", nil, memberOf); err != nil {
+		return
+	}
+
+	posixLock.Lock()
+	defer posixLock.Unlock()
+	if err = s.assignUIDNumber(ctx, acc); err != nil {
+		return
+	}
+	if err = s.checkUniqueAccount(ctx, acc, nil); err != nil {
+		return
+	}
+
+	// the lifecycle metadata is maintained by the service, values sent by clients are ignored
+	now := timestamppb.Now()
+	acc.CreatedDateTime = now
+	acc.LastModifiedDateTime = now
+	acc.LastSignInDateTime = nil
+	acc.DeletedDateTime = nil
+	acc.DeletedAccountEnabled = false
+	// api keys are only created with CreateApiKey, invitations with InviteGuest
+	acc.ApiKeys = nil
+	acc.Invitation = nil
+	if acc.ExternalUserState != "" {
+		acc.ExternalUserStateChangeDateTime = now
+	}
+
 	if acc.PasswordProfile != nil {
 		if acc.PasswordProfile.Password != "" {
 			// encrypt password
@@ -335,6 +460,7 @@ func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountReque
 				s.log.Error().Err(err).Str("id", id).Msg("could not hash password")
 				return merrors.InternalServerError(s.id, "could not hash password: %v", err.Error())
 			}
+			acc.PasswordProfile.LastPasswordChangeDateTime = now
 		}
 
 		if err := passwordPoliciesValid(acc.PasswordProfile.PasswordPolicies); err != nil {
@@ -342,43 +468,58 @@ func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountReque
 		}
 	}
 
-	// extract group id
-	// TODO groups should be ignored during create, use groups.AddMember? return error?
-	if err = s.writeAccount(acc); err != nil {
+	if err = s.writeAccount(ctx, acc); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not persist new account")
 		s.debugLogAccount(acc).Msg("could not persist new account")
 		return
 	}
 
 	// TODO: assign user role to all new users for now, as create Account request does not have any role field
-	_, err = s.RoleService.AssignRoleToUser(ctx, &settings.AssignRoleToUserRequest{
-		AccountUuid: acc.Id,
-		RoleId:      settings_svc.BundleUUIDRoleUser,
-	})
+	// service accounts are not users, their roles are assigned explicitly. Guests get the guest role.
+	if !acc.IsServiceAccount {
+		roleID := settings_svc.BundleUUIDRoleUser
+		if acc.UserType == userTypeGuest {
+			roleID = settings_svc.BundleUUIDRoleGuest
+		}
+		roleCtx, roleSpan := startSettingsSpan(ctx, "AssignRoleToUser", trace.StringAttribute("account_id", acc.Id))
+		_, err = s.RoleService.AssignRoleToUser(roleCtx, &settings.AssignRoleToUserRequest{
+			AccountUuid: acc.Id,
+			RoleId:      roleID,
+		})
+		endSpan(roleSpan, err)
 
-	if err != nil {
-		return merrors.InternalServerError(s.id, "could not assign role to account: %v", err.Error())
+		if err != nil {
+			return merrors.InternalServerError(s.id, "could not assign role to account: %v", err.Error())
+		}
 	}
 
-	if err = s.indexAccount(acc.Id); err != nil {
+	if err = s.updateMemberOf(ctx, acc, memberOf); err != nil {
+		s.log.Error().Err(err).Str("id", acc.Id).Msg("could not add new account to groups")
+		return
+	}
+
+	if err = s.indexAccount(ctx, acc.Id); err != nil {
 		return merrors.InternalServerError(s.id, "could not index new account: %v", err.Error())
 	}
 
 	s.log.Debug().Interface("account", acc).Msg("account after indexing")
+	s.audit(ctx, "create", "account", acc.Id, "", nil)
+	s.countDocuments(ctx, "account")
 
 	if acc.PasswordProfile != nil {
 		acc.PasswordProfile.Password = ""
 	}
 
-	{
-		out.Id = acc.Id
-		out.Mail = acc.Mail
-		out.PreferredName = acc.PreferredName
-		out.AccountEnabled = acc.AccountEnabled
-		out.DisplayName = acc.DisplayName
-		out.OnPremisesSamAccountName = acc.OnPremisesSamAccountName
+	if err = s.loadAccount(ctx, acc.Id, out); err != nil {
+		s.log.Error().Err(err).Str("id", acc.Id).Msg("could not load new account")
+		return
 	}
 
+	s.expandMemberOf(ctx, out)
+
+	// remove password and api key hashes
+	hideSecrets(out)
+
 	return
 }
 
@@ -386,8 +527,16 @@ func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountReque
 // read only fields are ignored
 // TODO how can we unset specific values? using the update mask
 func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountRequest, out *proto.Account) (err error) {
-	if !s.hasAccountManagementPermissions(ctx) {
-		return merrors.Forbidden(s.id, "no permission for UpdateAccount")
+	ctx, span := startSpan(ctx, "AccountsService.UpdateAccount", trace.StringAttribute("account_id", in.GetAccount().GetId()))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "UpdateAccount", func(a *access) bool { return a.mayManageAccounts() || a.hasAccount() }); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
 	}
 
 	accLock.Lock()
@@ -396,6 +545,9 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 	if in.Account == nil {
 		return merrors.BadRequest(s.id, "account missing")
 	}
+	if in.Account.Id == "" {
+		in.Account.Id = acl.accountID
+	}
 	if in.Account.Id == "" {
 		return merrors.BadRequest(s.id, "account id missing")
 	}
@@ -406,10 +558,28 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 
 	path := filepath.Join(s.Config.Server.AccountsDataPath, "accounts", id)
 
-	if err = s.loadAccount(id, out); err != nil {
+	if err = s.loadAccount(ctx, id, out); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
 		return
 	}
+	// users that do not manage their own account may only change their profile
+	updatable := updatableAccountPaths
+	selfService := false
+	if !acl.managesAccount(out) {
+		if !acl.isSelf(out) {
+			return s.forbidden("UpdateAccount")
+		}
+		updatable = selfServiceAccountPaths
+		selfService = true
+	}
+	// an update without a mask keeps the values of the paths that have to be named explicitly
+	if len(in.GetUpdateMask().GetPaths()) == 0 {
+		updatable = withoutPaths(updatable, explicitAccountPaths)
+	}
+
+	if out.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "account %s is deleted", id)
+	}
 
 	t := time.Now()
 	tsnow := &timestamppb.Timestamp{
@@ -417,15 +587,85 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 		Nanos:   int32(t.Nanosecond()),
 	}
 
-	validMask, err := validateUpdate(in.UpdateMask, updatableAccountPaths)
-	if err != nil {
-		return merrors.BadRequest(s.id, "%s", err)
+	// recorded before the memberships are split off the mask
+	changedPaths := updatedPaths(in.GetUpdateMask().GetPaths(), updatable)
+
+	// memberships are not part of the updatable paths, they are only changed when explicitly requested
+	var memberOf []*proto.Group
+	updateMemberOf := false
+	if in.UpdateMask != nil {
+		paths := []string{}
+		for _, p := range in.UpdateMask.Paths {
+			if p == "MemberOf" {
+				updateMemberOf = true
+				continue
+			}
+			paths = append(paths, p)
+		}
+		in.UpdateMask.Paths = paths
+	}
+	if updateMemberOf {
+		if selfService {
+			return s.forbidden("UpdateAccount")
+		}
+		if memberOf, err = s.resolveGroups(ctx, in.Account.MemberOf); err != nil {
+			return
+		}
+		if err = s.checkMemberships(ctx, acl, "UpdateAccount", out.MemberOf, memberOf); err != nil {
+			return
+		}
 	}
 
-	if err := fieldmask_utils.StructToStruct(validMask, in.Account, out); err != nil {
-		return merrors.InternalServerError(s.id, "%s", err)
+	var validMask fieldmask_utils.FieldFilterContainer
+	if !updateMemberOf || len(in.UpdateMask.Paths) > 0 {
+		if validMask, err = validateUpdate(in.UpdateMask, updatable); err != nil {
+			return merrors.BadRequest(s.id, "%s", err)
+		}
 	}
 
+	// users have to prove they know the password they change
+	if selfService && in.Account.PasswordProfile.GetPassword() != "" {
+		if out.PasswordProfile == nil || !s.passwordIsValid(out.PasswordProfile.Password, in.OldPassword) {
+			in.Account.PasswordProfile.Password = ""
+			return merrors.Forbidden(s.id, "old password does not match")
+		}
+		// the password has been chosen by the user
+		out.PasswordProfile.ForceChangePasswordNextSignIn = false
+	}
+
+	posixLock.Lock()
+	defer posixLock.Unlock()
+	prev := &proto.Account{
+		PreferredName:            out.PreferredName,
+		OnPremisesSamAccountName: out.OnPremisesSamAccountName,
+		Mail:                     out.Mail,
+		UidNumber:                out.UidNumber,
+		AccountEnabled:           out.AccountEnabled,
+		ExternalUserState:        out.ExternalUserState,
+	}
+
+	if validMask != nil {
+		if err := fieldmask_utils.StructToStruct(validMask, in.Account, out); err != nil {
+			return merrors.InternalServerError(s.id, "%s", err)
+		}
+	}
+
+	if !prev.AccountEnabled && out.AccountEnabled && expired(out.ExpirationDateTime, t) {
+		return merrors.BadRequest(s.id, "expired accounts can not be enabled, extend the expiration_date_time first")
+	}
+	if out.UidNumber < 0 || out.GidNumber < 0 {
+		return merrors.BadRequest(s.id, "uid_number and gid_number must not be negative")
+	}
+	if out.UidNumber != prev.UidNumber && out.UidNumber != 0 {
+		if err = s.checkNumber(ctx, "account", "uid_number", out.UidNumber, id); err != nil {
+			return
+		}
+	}
+	if err = s.checkUniqueAccount(ctx, out, prev); err != nil {
+		return
+	}
+
+	passwordChanged := false
 	if in.Account.PasswordProfile != nil {
 		if out.PasswordProfile == nil {
 			out.PasswordProfile = &proto.PasswordProfile{}
@@ -440,41 +680,73 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 			}
 
 			in.Account.PasswordProfile.Password = ""
+
+			// lastPasswordChangeDateTime calculated, see password
+			out.PasswordProfile.LastPasswordChangeDateTime = tsnow
+			passwordChanged = true
 		}
 
 		if err := passwordPoliciesValid(in.Account.PasswordProfile.PasswordPolicies); err != nil {
 			return merrors.BadRequest(s.id, "%s", err)
 		}
-
-		// lastPasswordChangeDateTime calculated, see password
-		out.PasswordProfile.LastPasswordChangeDateTime = tsnow
 	}
 
-	// out.RefreshTokensValidFromDateTime TODO use to invalidate all existing sessions
-	// out.SignInSessionsValidFromDateTime TODO use to invalidate all existing sessions
+	// changing the password or disabling the account signs it out everywhere
+	if passwordChanged || (prev.AccountEnabled && !out.AccountEnabled) {
+		revokeSessions(out, tsnow)
+	}
 
 	// ... TODO on prem for sync
 
-	if out.ExternalUserState != in.Account.ExternalUserState {
-		out.ExternalUserState = in.Account.ExternalUserState
+	// the state is only copied by the mask, so updates of other properties keep invitations redeemable
+	if out.ExternalUserState != prev.ExternalUserState {
 		out.ExternalUserStateChangeDateTime = tsnow
 	}
 
-	if err = s.writeAccount(out); err != nil {
+	out.LastModifiedDateTime = tsnow
+
+	if err = s.writeAccount(ctx, out); err != nil {
 		s.log.Error().Err(err).Str("id", out.Id).Msg("could not persist updated account")
 		return
 	}
 
-	if err = s.indexAccount(id); err != nil {
+	if updateMemberOf {
+		if err = s.updateMemberOf(ctx, out, memberOf); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not update group memberships")
+			return
+		}
+	}
+
+	if err = s.indexAccount(ctx, id); err != nil {
 		s.log.Error().Err(err).Str("id", id).Str("path", path).Msg("could not index new account")
 		return merrors.InternalServerError(s.id, "could not index updated account: %v", err.Error())
 	}
+	s.audit(ctx, "update", "account", id, "", changedPaths)
+	if out.AccountEnabled != prev.AccountEnabled {
+		e := &proto.Event{Type: proto.EventAccountDisabled, Timestamp: tsnow, AccountId: id}
+		if out.AccountEnabled {
+			e.Type = proto.EventAccountEnabled
+		}
+		e.Actor = actor(ctx)
+		s.publish(e)
+	}
+	if passwordChanged {
+		e := &proto.Event{Type: proto.EventAccountSessionsRevoked, Timestamp: tsnow, AccountId: id}
+		e.Actor = actor(ctx)
+		s.publish(e)
+	}
 
-	// remove password
-	if out.PasswordProfile != nil {
-		out.PasswordProfile.Password = ""
+	if updateMemberOf {
+		if err = s.loadAccount(ctx, id, out); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not load updated account")
+			return
+		}
+		s.expandMemberOf(ctx, out)
 	}
 
+	// remove password and api key hashes
+	hideSecrets(out)
+
 	return
 }
 
@@ -488,19 +760,46 @@ var updatableAccountPaths = map[string]struct{}{
 	"UidNumber":                        {},
 	"GidNumber":                        {},
 	"Description":                      {},
+	"PreferredLanguage":                {},
 	"Mail":                             {}, // read only?,
 	"PasswordProfile.Password":         {},
 	"PasswordProfile.PasswordPolicies": {},
 	"PasswordProfile.ForceChangePasswordNextSignIn":        {},
 	"PasswordProfile.ForceChangePasswordNextSignInWithMfa": {},
 	"OnPremisesSyncEnabled":                                {},
+	"OnPremisesImmutableId":                                {},
 	"OnPremisesSamAccountName":                             {},
+	"ExternalUserState":                                    {},
+	"ExpirationDateTime":                                   {},
+	"ScheduledEnableDateTime":                              {},
+}
+
+// paths/fields that are only updated when the update mask names them, e.g. to not reset the state of pending guests
+var explicitAccountPaths = map[string]struct{}{
+	"ExternalUserState": {},
+}
+
+// whitelist of the paths/fields users can update in their own account
+var selfServiceAccountPaths = map[string]struct{}{
+	"DisplayName":              {},
+	"Description":              {},
+	"PreferredLanguage":        {},
+	"PasswordProfile.Password": {},
 }
 
 // DeleteAccount implements the AccountsServiceHandler interface
+// the account is only marked as deleted and disabled, it is purged after the configured retention period
 func (s Service) DeleteAccount(ctx context.Context, in *proto.DeleteAccountRequest, out *empty.Empty) (err error) {
-	if !s.hasAccountManagementPermissions(ctx) {
-		return merrors.Forbidden(s.id, "no permission for DeleteAccount")
+	ctx, span := startSpan(ctx, "AccountsService.DeleteAccount", trace.StringAttribute("account_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "DeleteAccount", (*access).mayManageAccounts); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
 	}
 
 	accLock.Lock()
@@ -509,17 +808,24 @@ func (s Service) DeleteAccount(ctx context.Context, in *proto.DeleteAccountReque
 	if id, err = cleanupID(in.Id); err != nil {
 		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
 	}
-	path := filepath.Join(s.Config.Server.AccountsDataPath, "accounts", id)
 
 	a := &proto.Account{}
-	if err = s.loadAccount(id, a); err != nil {
+	if err = s.loadAccount(ctx, id, a); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
 		return
 	}
+	if !acl.managesAccount(a) {
+		return s.forbidden("DeleteAccount")
+	}
+
+	if a.DeletedDateTime != nil {
+		s.log.Debug().Str("id", id).Msg("account already deleted")
+		return
+	}
 
 	// delete member relationship in groups
 	for i := range a.MemberOf {
-		err = s.RemoveMember(ctx, &proto.RemoveMemberRequest{
+		err = s.RemoveMember(s.asService(ctx), &proto.RemoveMemberRequest{
 			GroupId:   a.MemberOf[i].Id,
 			AccountId: id,
 		}, a.MemberOf[i])
@@ -528,20 +834,111 @@ func (s Service) DeleteAccount(ctx context.Context, in *proto.DeleteAccountReque
 		}
 	}
 
-	if err = os.Remove(path); err != nil {
-		s.log.Error().Err(err).Str("id", id).Str("path", path).Msg("could not remove account")
-		return merrors.InternalServerError(s.id, "could not remove account: %v", err.Error())
+	// the groups are kept in memberOf and the enabled state in deleted_account_enabled so they can be restored
+	a.DeletedAccountEnabled = a.AccountEnabled
+	a.AccountEnabled = false
+	a.DeletedDateTime = timestamppb.Now()
+	a.LastModifiedDateTime = a.DeletedDateTime
+	revokeSessions(a, a.DeletedDateTime)
+
+	if err = s.writeAccount(ctx, a); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist deleted account")
+		return
 	}
 
-	if err = s.index.Delete(id); err != nil {
-		s.log.Error().Err(err).Str("id", id).Str("path", path).Msg("could not remove account from index")
-		return merrors.InternalServerError(s.id, "could not remove account from index: %v", err.Error())
+	if err = s.indexAccount(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index deleted account: %v", err.Error())
 	}
+	s.audit(ctx, "delete", "account", id, "", nil)
 
 	s.log.Info().Str("id", id).Msg("deleted account")
 	return
 }
 
+// RestoreAccount implements the AccountsServiceHandler interface
+// the account is enabled again and added back to the groups it was a member of
+func (s Service) RestoreAccount(ctx context.Context, in *proto.RestoreAccountRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.RestoreAccount", trace.StringAttribute("account_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "RestoreAccount", (*access).mayManageAccounts); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	var id string
+	if id, err = cleanupID(in.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	a := &proto.Account{}
+	if err = s.loadAccount(ctx, id, a); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+	// deleted accounts keep their memberships, so they stay in the scope they were deleted from
+	if !acl.managesAccount(a) {
+		return s.forbidden("RestoreAccount")
+	}
+
+	if a.DeletedDateTime == nil {
+		return merrors.BadRequest(s.id, "account %s is not deleted", id)
+	}
+
+	// other accounts might have taken over its names, e.g. if they were created before they had to be unique
+	if err = s.checkUniqueAccount(ctx, a, nil); err != nil {
+		return
+	}
+
+	memberOf := a.MemberOf
+	a.MemberOf = nil
+	a.DeletedDateTime = nil
+	a.LastModifiedDateTime = timestamppb.Now()
+	// accounts that were disabled before the deletion or expired while deleted stay disabled
+	a.AccountEnabled = a.DeletedAccountEnabled && !expired(a.ExpirationDateTime, a.LastModifiedDateTime.AsTime())
+	a.DeletedAccountEnabled = false
+
+	if err = s.writeAccount(ctx, a); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist restored account")
+		return
+	}
+
+	// restore member relationship in groups
+	for i := range memberOf {
+		err = s.AddMember(s.asService(ctx), &proto.AddMemberRequest{
+			GroupId:   memberOf[i].Id,
+			AccountId: id,
+		}, &proto.Group{})
+		if err != nil {
+			s.log.Error().Err(err).Str("accountid", id).Str("groupid", memberOf[i].Id).Msg("could not restore group member, skipping")
+		}
+	}
+
+	if err = s.indexAccount(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index restored account: %v", err.Error())
+	}
+	s.audit(ctx, "restore", "account", id, "", nil)
+
+	if err = s.loadAccount(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load restored account")
+		return
+	}
+
+	s.expandMemberOf(ctx, out)
+
+	// remove password and api key hashes
+	hideSecrets(out)
+
+	s.log.Info().Str("id", id).Msg("restored account")
+	return
+}
+
 // We want to allow email addresses as usernames so they show up when using them in ACLs on storages that allow intergration with our glauth LDAP service
 // so we are adding a few restrictions from https://stackoverflow.com/questions/6949667/what-are-the-real-rules-for-linux-usernames-on-centos-6-and-rhel-6
 // names should not start with numbers
@@ -608,6 +1005,17 @@ func validateUpdate(mask *field_mask.FieldMask, updatablePaths map[string]struct
 	return fieldmask_utils.MaskFromPaths(mask.Paths, nop)
 }
 
+// withoutPaths returns the updatable paths that are not excluded
+func withoutPaths(updatablePaths map[string]struct{}, excluded map[string]struct{}) map[string]struct{} {
+	paths := make(map[string]struct{}, len(updatablePaths))
+	for p := range updatablePaths {
+		if _, ok := excluded[p]; !ok {
+			paths[p] = struct{}{}
+		}
+	}
+	return paths
+}
+
 // debugLogAccount returns a debug-log event with detailed account-info, and filtered password data
 func (s Service) debugLogAccount(a *proto.Account) *zerolog.Event {
 	return s.log.Debug().Fields(map[string]interface{}{
@@ -630,5 +1038,7 @@ func (s Service) debugLogAccount(a *proto.Account) *zerolog.Event {
 		"MemberOf":                     a.MemberOf,
 		"CreatedDateTime":              a.CreatedDateTime,
 		"DeletedDateTime":              a.DeletedDateTime,
+		"LastModifiedDateTime":         a.LastModifiedDateTime,
+		"LastSignInDateTime":           a.LastSignInDateTime,
 	})
 }
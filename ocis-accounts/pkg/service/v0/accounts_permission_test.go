@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"log"
 	"net/http"
 	"os"
@@ -12,12 +11,11 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/micro/go-micro/v2/client"
 	merrors "github.com/micro/go-micro/v2/errors"
-	"github.com/micro/go-micro/v2/metadata"
 	olog "github.com/owncloud/ocis-pkg/v2/log"
-	"github.com/owncloud/ocis-pkg/v2/middleware"
 	"github.com/owncloud/ocis-pkg/v2/roles"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +32,7 @@ func init() {
 	cfg := config.New()
 	cfg.Server.Name = "accounts"
 	cfg.Server.AccountsDataPath = dataPath
+	cfg.Bootstrap.DemoUsers = true
 	logger := olog.NewLogger(olog.Color(true), olog.Pretty(true))
 	roleServiceMock = buildRoleServiceMock()
 	roleManager := roles.NewManager(
@@ -68,15 +67,11 @@ func TestPermissionsListAccounts(t *testing.T) {
 		query           string
 		permissionError error
 	}{
-		// TODO: remove this test when https://github.com/refs/ocis-mono/ocis-accounts/pull/111 is merged
-		// replace with two tests:
-		// 1: "ListAccounts fails with 403 when roleIDs don't exist in context"
-		// 2: "ListAccounts fails with 403 when ('no admin role in context' AND 'empty query')"
 		{
-			"ListAccounts succeeds when no roleIDs in context",
+			"ListAccounts fails when unauthenticated",
 			nil,
 			"",
-			nil,
+			merrors.Unauthorized(s.id, "authentication required for ListAccounts"),
 		},
 		{
 			"ListAccounts fails when no admin roleID in context",
@@ -115,7 +110,6 @@ func TestPermissionsListAccounts(t *testing.T) {
 }
 
 // TestPermissionsGetAccount checks permission handling on GetAccount
-// TODO: remove this test function entirely, when https://github.com/refs/ocis-mono/ocis-accounts/pull/111 is merged. GetAccount will not have permission checks for the time being.
 func TestPermissionsGetAccount(t *testing.T) {
 	var scenarios = []struct {
 		name            string
@@ -123,14 +117,14 @@ func TestPermissionsGetAccount(t *testing.T) {
 		permissionError error
 	}{
 		{
-			"GetAccount succeeds when no role IDs in context",
-			nil,
+			"GetAccount fails when unauthenticated",
 			nil,
+			merrors.Unauthorized(s.id, "authentication required for GetAccount"),
 		},
 		{
-			"GetAccount fails when no admin roleID in context",
+			"GetAccount on the own account succeeds when no admin roleID in context",
 			[]string{ssvc.BundleUUIDRoleUser, ssvc.BundleUUIDRoleGuest},
-			merrors.Forbidden(s.id, "no permission for GetAccount"),
+			nil,
 		},
 		{
 			"GetAccount succeeds when admin roleID in context",
@@ -166,14 +160,10 @@ func TestPermissionsCreateAccount(t *testing.T) {
 		roleIDs         []string
 		permissionError error
 	}{
-		// TODO: remove this test when https://github.com/refs/ocis-mono/ocis-accounts/pull/111 is merged
-		// replace with two tests:
-		// 1: "CreateAccount fails with 403 when roleIDs don't exist in context"
-		// 2: "CreateAccount fails with 403 when no admin role in context"
 		{
-			"CreateAccount succeeds when no role IDs in context",
-			nil,
+			"CreateAccount fails when unauthenticated",
 			nil,
+			merrors.Unauthorized(s.id, "authentication required for CreateAccount"),
 		},
 		{
 			"CreateAccount fails when no admin roleID in context",
@@ -214,19 +204,15 @@ func TestPermissionsUpdateAccount(t *testing.T) {
 		roleIDs         []string
 		permissionError error
 	}{
-		// TODO: remove this test when https://github.com/refs/ocis-mono/ocis-accounts/pull/111 is merged
-		// replace with two tests:
-		// 1: "UpdateAccount fails with 403 when roleIDs don't exist in context"
-		// 2: "UpdateAccount fails with 403 when no admin role in context"
 		{
-			"UpdateAccount succeeds when no role IDs in context",
-			nil,
+			"UpdateAccount fails when unauthenticated",
 			nil,
+			merrors.Unauthorized(s.id, "authentication required for UpdateAccount"),
 		},
 		{
-			"UpdateAccount fails when no admin roleID in context",
+			"UpdateAccount on the own account succeeds when no admin roleID in context",
 			[]string{ssvc.BundleUUIDRoleUser, ssvc.BundleUUIDRoleGuest},
-			merrors.Forbidden(s.id, "no permission for UpdateAccount"),
+			nil,
 		},
 		{
 			"UpdateAccount succeeds when admin roleID in context",
@@ -262,14 +248,10 @@ func TestPermissionsDeleteAccount(t *testing.T) {
 		roleIDs         []string
 		permissionError error
 	}{
-		// TODO: remove this test when https://github.com/refs/ocis-mono/ocis-accounts/pull/111 is merged
-		// replace with two tests:
-		// 1: "DeleteAccount fails with 403 when roleIDs don't exist in context"
-		// 2: "DeleteAccount fails with 403 when no admin role in context"
 		{
-			"DeleteAccount succeeds when no role IDs in context",
-			nil,
+			"DeleteAccount fails when unauthenticated",
 			nil,
+			merrors.Unauthorized(s.id, "authentication required for DeleteAccount"),
 		},
 		{
 			"DeleteAccount fails when no admin roleID in context",
@@ -303,16 +285,57 @@ func TestPermissionsDeleteAccount(t *testing.T) {
 	}
 }
 
+// buildTestCtx returns the context of a request of a user with the given roles. Without roles the request is
+// unauthenticated.
 func buildTestCtx(t *testing.T, roleIDs []string) context.Context {
 	ctx := context.Background()
 	if roleIDs != nil {
-		roleIDs, err := json.Marshal(roleIDs)
-		assert.NoError(t, err)
-		ctx = metadata.Set(ctx, middleware.RoleIDs, string(roleIDs))
+		ctx = auth.NewContext(ctx, auth.Principal{AccountID: "4c510ada-c86b-4815-8820-42cdf82c3d51", RoleIDs: roleIDs})
 	}
 	return ctx
 }
 
+// delegatedCtx returns the context of a request of a service calling on behalf of the user with the given roles
+func delegatedCtx(accountID string, roleIDs ...string) context.Context {
+	return auth.NewContext(context.Background(), auth.Principal{Service: "com.owncloud.api.proxy", AccountID: accountID, RoleIDs: roleIDs})
+}
+
+// buildRoleManager returns a role manager reading the roles from the role service
+func buildRoleManager(roleService settings.RoleService) *roles.Manager {
+	roleManager := roles.NewManager(
+		roles.Logger(olog.NewLogger()),
+		roles.RoleService(roleService),
+		roles.CacheTTL(time.Hour),
+		roles.CacheSize(1024),
+	)
+	return &roleManager
+}
+
+// serviceCtx returns the context of a request of a service calling on its own behalf
+func serviceCtx() context.Context {
+	return auth.NewContext(context.Background(), auth.Principal{Service: "com.owncloud.api.test"})
+}
+
+// testRole returns a role holding a single permission
+func testRole(id, permissionID string, operation settings.Permission_Operation, constraint settings.Permission_Constraint, resourceID string) *settings.Bundle {
+	return &settings.Bundle{
+		Id:   id,
+		Type: settings.Bundle_TYPE_ROLE,
+		Resource: &settings.Resource{
+			Type: settings.Resource_TYPE_SYSTEM,
+		},
+		Settings: []*settings.Setting{
+			{
+				Id:       permissionID,
+				Resource: &settings.Resource{Type: settings.Resource_TYPE_GROUP, Id: resourceID},
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{Operation: operation, Constraint: constraint},
+				},
+			},
+		},
+	}
+}
+
 func buildRoleServiceMock() settings.RoleService {
 	defaultRoles := map[string]*settings.Bundle{
 		ssvc.BundleUUIDRoleAdmin: {
@@ -325,6 +348,9 @@ func buildRoleServiceMock() settings.RoleService {
 				{
 					Id: AccountManagementPermissionID,
 				},
+				{
+					Id: GroupManagementPermissionID,
+				},
 			},
 		},
 		ssvc.BundleUUIDRoleUser: {
@@ -343,6 +369,10 @@ func buildRoleServiceMock() settings.RoleService {
 			},
 			Settings: []*settings.Setting{},
 		},
+		readerRoleID:        testRole(readerRoleID, DirectoryReadPermissionID, settings.Permission_OPERATION_READ, settings.Permission_CONSTRAINT_ALL, "all"),
+		scopedAdminRoleID:   testRole(scopedAdminRoleID, ScopedAccountManagementPermissionID, settings.Permission_OPERATION_READWRITE, settings.Permission_CONSTRAINT_ALL, radiumLoversID),
+		readOnlyAdminRoleID: testRole(readOnlyAdminRoleID, AccountManagementPermissionID, settings.Permission_OPERATION_READ, settings.Permission_CONSTRAINT_ALL, "all"),
+		memberManagerRoleID: testRole(memberManagerRoleID, GroupMemberManagementPermissionID, settings.Permission_OPERATION_READWRITE, settings.Permission_CONSTRAINT_ALL, radiumLoversID),
 	}
 	return settings.MockRoleService{
 		ListRolesFunc: func(ctx context.Context, req *settings.ListBundlesRequest, opts ...client.CallOption) (res *settings.ListBundlesResponse, err error) {
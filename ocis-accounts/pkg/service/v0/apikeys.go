@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/tredoe/osutil/user/crypt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// apiKeySecretLength is the number of random bytes of the secret of an api key
+const apiKeySecretLength = 32
+
+// randomSecret returns a url safe encoding of n random bytes
+func randomSecret(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// excludeServiceAccounts wraps the query so it does not match service accounts
+func excludeServiceAccounts(q bquery.Query) bquery.Query {
+	sq := bleve.NewBoolFieldQuery(true)
+	sq.SetField("is_service_account")
+
+	bq := bleve.NewBooleanQuery()
+	bq.AddMust(q)
+	bq.AddMustNot(sq)
+	return bq
+}
+
+// hideSecrets removes the password hash and the hashes of the api keys and the invitation before an account is
+// returned
+func hideSecrets(a *proto.Account) {
+	if a.PasswordProfile != nil {
+		a.PasswordProfile.Password = ""
+	}
+	for i := range a.ApiKeys {
+		a.ApiKeys[i].Hash = ""
+	}
+	if a.Invitation != nil {
+		a.Invitation.Hash = ""
+	}
+}
+
+// expired checks if an expiration date time lies in the past
+func expired(ts *timestamppb.Timestamp, t time.Time) bool {
+	return ts != nil && !ts.AsTime().After(t)
+}
+
+// issueAPIKey generates a new secret for the key and returns the key clients authenticate with
+func (s Service) issueAPIKey(k *proto.ApiKey) (string, error) {
+	secret, err := randomSecret(apiKeySecretLength)
+	if err != nil {
+		return "", merrors.InternalServerError(s.id, "could not generate api key: %v", err.Error())
+	}
+	c := crypt.New(crypt.SHA512)
+	if k.Hash, err = c.Generate([]byte(secret), nil); err != nil {
+		return "", merrors.InternalServerError(s.id, "could not hash api key: %v", err.Error())
+	}
+	k.CreatedDateTime = timestamppb.Now()
+	k.LastUsedDateTime = nil
+	return k.Id + "." + secret, nil
+}
+
+// loadServiceAccount loads the account an api key is changed for
+func (s Service) loadServiceAccount(ctx context.Context, accountID string, a *proto.Account) (err error) {
+	var id string
+	if id, err = cleanupID(accountID); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+	if err = s.loadAccount(ctx, id, a); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+	if !a.IsServiceAccount {
+		return merrors.BadRequest(s.id, "account %s is not a service account", id)
+	}
+	if a.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "account %s is deleted", id)
+	}
+	return nil
+}
+
+// persistAPIKeys writes and indexes the account after its api keys have been changed
+func (s Service) persistAPIKeys(ctx context.Context, a *proto.Account) (err error) {
+	a.LastModifiedDateTime = timestamppb.Now()
+	if err = s.writeAccount(ctx, a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist api keys")
+		return
+	}
+	if err = s.indexAccount(ctx, a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	return nil
+}
+
+// CreateApiKey implements the AccountsServiceHandler interface
+func (s Service) CreateApiKey(ctx context.Context, in *proto.CreateApiKeyRequest, out *proto.ApiKeySecret) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.CreateApiKey", trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, AccountManagementPermissionID, "CreateApiKey"); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+	if in.Name == "" {
+		return merrors.BadRequest(s.id, "name missing")
+	}
+	if expired(in.ExpirationDateTime, time.Now()) {
+		return merrors.BadRequest(s.id, "expiration_date_time must lie in the future")
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	a := &proto.Account{}
+	if err = s.loadServiceAccount(ctx, in.AccountId, a); err != nil {
+		return
+	}
+	for _, k := range a.ApiKeys {
+		if strings.EqualFold(k.Name, in.Name) {
+			return merrors.Conflict(s.id, "name '%s' is already used by api key %s", in.Name, k.Id)
+		}
+	}
+
+	k := &proto.ApiKey{
+		Id:                 uuid.Must(uuid.NewV4()).String(),
+		Name:               in.Name,
+		ExpirationDateTime: in.ExpirationDateTime,
+	}
+	if out.Key, err = s.issueAPIKey(k); err != nil {
+		return
+	}
+	a.ApiKeys = append(a.ApiKeys, k)
+	if err = s.persistAPIKeys(ctx, a); err != nil {
+		return
+	}
+	s.audit(ctx, "create_api_key", "account", a.Id, k.Id, nil)
+
+	k.Hash = ""
+	out.ApiKey = k
+	return nil
+}
+
+// RotateApiKey implements the AccountsServiceHandler interface
+func (s Service) RotateApiKey(ctx context.Context, in *proto.RotateApiKeyRequest, out *proto.ApiKeySecret) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.RotateApiKey", trace.StringAttribute("account_id", in.AccountId), trace.StringAttribute("key_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, AccountManagementPermissionID, "RotateApiKey"); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+	if expired(in.ExpirationDateTime, time.Now()) {
+		return merrors.BadRequest(s.id, "expiration_date_time must lie in the future")
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	a := &proto.Account{}
+	if err = s.loadServiceAccount(ctx, in.AccountId, a); err != nil {
+		return
+	}
+	var k *proto.ApiKey
+	for i := range a.ApiKeys {
+		if a.ApiKeys[i].Id == in.Id {
+			k = a.ApiKeys[i]
+		}
+	}
+	if k == nil {
+		return merrors.NotFound(s.id, "api key %s not found", in.Id)
+	}
+
+	k.ExpirationDateTime = in.ExpirationDateTime
+	if out.Key, err = s.issueAPIKey(k); err != nil {
+		return
+	}
+	if err = s.persistAPIKeys(ctx, a); err != nil {
+		return
+	}
+	s.audit(ctx, "rotate_api_key", "account", a.Id, k.Id, nil)
+
+	k.Hash = ""
+	out.ApiKey = k
+	return nil
+}
+
+// RevokeApiKey implements the AccountsServiceHandler interface
+func (s Service) RevokeApiKey(ctx context.Context, in *proto.RevokeApiKeyRequest, out *empty.Empty) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.RevokeApiKey", trace.StringAttribute("account_id", in.AccountId), trace.StringAttribute("key_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, AccountManagementPermissionID, "RevokeApiKey"); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	a := &proto.Account{}
+	if err = s.loadServiceAccount(ctx, in.AccountId, a); err != nil {
+		return
+	}
+	keys := make([]*proto.ApiKey, 0, len(a.ApiKeys))
+	for i := range a.ApiKeys {
+		if a.ApiKeys[i].Id != in.Id {
+			keys = append(keys, a.ApiKeys[i])
+		}
+	}
+	if len(keys) == len(a.ApiKeys) {
+		return merrors.NotFound(s.id, "api key %s not found", in.Id)
+	}
+
+	a.ApiKeys = keys
+	if err = s.persistAPIKeys(ctx, a); err != nil {
+		return
+	}
+	s.audit(ctx, "revoke_api_key", "account", a.Id, in.Id, nil)
+	return nil
+}
+
+// AuthenticateApiKey implements the AccountsServiceHandler interface. Unknown, expired and revoked keys as well as keys
+// of disabled or deleted accounts are rejected with the same error.
+func (s Service) AuthenticateApiKey(ctx context.Context, in *proto.AuthenticateApiKeyRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.AuthenticateApiKey")
+	defer func() { endSpan(span, err) }()
+
+	if _, err = s.authorize(ctx, "AuthenticateApiKey", (*access).mayReadAccounts); err != nil {
+		return
+	}
+
+	invalid := merrors.Unauthorized(s.id, "invalid api key")
+	keyID := in.Key
+	var secret string
+	if i := strings.Index(in.Key, "."); i > 0 {
+		keyID, secret = in.Key[:i], in.Key[i+1:]
+	}
+	if secret == "" || s.ldap != nil {
+		s.metrics.ObserveAuthentication(false)
+		return invalid
+	}
+	span.AddAttributes(trace.StringAttribute("key_id", keyID))
+
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	tq := bleve.NewTermQuery("account")
+	tq.SetField("bleve_type")
+	kq := bleve.NewTermQuery(keyID)
+	kq.SetField("api_keys.id")
+
+	var searchResult *bleve.SearchResult
+	if searchResult, err = s.search(ctx, bleve.NewSearchRequest(excludeDeleted(bleve.NewConjunctionQuery(tq, kq)))); err != nil {
+		s.log.Error().Err(err).Msg("could not execute bleve search")
+		return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+	}
+	if len(searchResult.Hits) != 1 {
+		s.metrics.ObserveAuthentication(false)
+		return invalid
+	}
+
+	if err = s.loadAccount(ctx, searchResult.Hits[0].ID, out); err != nil {
+		s.log.Error().Err(err).Str("account", searchResult.Hits[0].ID).Msg("could not load account")
+		return
+	}
+	var k *proto.ApiKey
+	for i := range out.ApiKeys {
+		if out.ApiKeys[i].Id == keyID {
+			k = out.ApiKeys[i]
+		}
+	}
+	now := time.Now()
+	if k == nil || !out.IsServiceAccount || !out.AccountEnabled || expired(k.ExpirationDateTime, now) || expired(out.ExpirationDateTime, now) || !s.passwordIsValid(k.Hash, secret) {
+		s.metrics.ObserveAuthentication(false)
+		return invalid
+	}
+	s.metrics.ObserveAuthentication(true)
+
+	// remember the use of the key, failing to do so must not prevent the authentication
+	k.LastUsedDateTime = timestamppb.New(now)
+	out.LastSignInDateTime = k.LastUsedDateTime
+	if err := s.writeAccount(ctx, out); err != nil {
+		s.log.Error().Err(err).Str("id", out.Id).Msg("could not persist last use of api key")
+	} else if err := s.indexAccount(ctx, out.Id); err != nil {
+		s.log.Error().Err(err).Str("id", out.Id).Msg("could not index last use of api key")
+	}
+
+	s.expandMemberOf(ctx, out)
+	hideSecrets(out)
+	return nil
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const revaID = "bc596f3c-c955-4328-80a0-60d018b4ad57"
+
+func assertCode(t *testing.T, code int32, err error) {
+	require.Error(t, err)
+	assert.EqualValues(t, code, merrors.FromError(err).GetCode(), err.Error())
+}
+
+func TestServiceAccountSearch(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	// service accounts are only listed on request
+	out := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{}, out))
+	assert.NotContains(t, accountIDs(out.Accounts), revaID)
+	assert.Contains(t, accountIDs(out.Accounts), einsteinID)
+
+	out = &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{ShowServiceAccounts: true}, out))
+	assert.Contains(t, accountIDs(out.Accounts), revaID)
+	assert.Contains(t, accountIDs(out.Accounts), einsteinID)
+
+	// authentication requests find service accounts
+	out = &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{
+		Query: "login eq 'reva' and password eq 'reva'",
+	}, out))
+	assert.Equal(t, []string{revaID}, accountIDs(out.Accounts))
+
+	// api keys are only created with CreateApiKey
+	created := &proto.Account{}
+	require.NoError(t, svc.CreateAccount(serviceCtx(), &proto.CreateAccountRequest{Account: &proto.Account{
+		PreferredName:            "ci",
+		OnPremisesSamAccountName: "ci",
+		Mail:                     "ci@example.org",
+		AccountEnabled:           true,
+		IsServiceAccount:         true,
+		ApiKeys:                  []*proto.ApiKey{{Id: "forged", Hash: "forged"}},
+	}}, created))
+	assert.True(t, created.IsServiceAccount)
+	assert.Empty(t, created.ApiKeys)
+}
+
+func TestAPIKeys(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	authenticate := func(key string) error {
+		return svc.AuthenticateApiKey(serviceCtx(), &proto.AuthenticateApiKeyRequest{Key: key}, &proto.Account{})
+	}
+
+	// only administrators manage keys, and only of service accounts
+	userCtx := buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser})
+	assertForbidden(t, svc.CreateApiKey(userCtx, &proto.CreateApiKeyRequest{AccountId: revaID, Name: "storage"}, &proto.ApiKeySecret{}))
+	assertCode(t, 400, svc.CreateApiKey(serviceCtx(), &proto.CreateApiKeyRequest{AccountId: einsteinID, Name: "storage"}, &proto.ApiKeySecret{}))
+	assertCode(t, 400, svc.CreateApiKey(serviceCtx(), &proto.CreateApiKeyRequest{AccountId: revaID}, &proto.ApiKeySecret{}))
+	assertCode(t, 400, svc.CreateApiKey(serviceCtx(), &proto.CreateApiKeyRequest{
+		AccountId:          revaID,
+		Name:               "storage",
+		ExpirationDateTime: timestamppb.New(time.Now().Add(-time.Hour)),
+	}, &proto.ApiKeySecret{}))
+
+	created := &proto.ApiKeySecret{}
+	require.NoError(t, svc.CreateApiKey(serviceCtx(), &proto.CreateApiKeyRequest{AccountId: revaID, Name: "storage"}, created))
+	assert.True(t, strings.HasPrefix(created.Key, created.ApiKey.Id+"."))
+	assert.Equal(t, "storage", created.ApiKey.Name)
+	assert.Empty(t, created.ApiKey.Hash)
+	assertCode(t, 409, svc.CreateApiKey(serviceCtx(), &proto.CreateApiKeyRequest{AccountId: revaID, Name: "Storage"}, &proto.ApiKeySecret{}))
+
+	a := &proto.Account{}
+	require.NoError(t, svc.AuthenticateApiKey(serviceCtx(), &proto.AuthenticateApiKeyRequest{Key: created.Key}, a))
+	assert.Equal(t, revaID, a.Id)
+	assertCode(t, 401, authenticate(created.ApiKey.Id+".wrong"))
+	assertCode(t, 401, authenticate(created.ApiKey.Id))
+
+	// the hashes are never returned
+	reva := &proto.Account{}
+	require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: revaID}, reva))
+	require.Len(t, reva.ApiKeys, 1)
+	assert.Empty(t, reva.ApiKeys[0].Hash)
+	assert.NotNil(t, reva.ApiKeys[0].LastUsedDateTime)
+
+	// rotating replaces the secret
+	rotated := &proto.ApiKeySecret{}
+	require.NoError(t, svc.RotateApiKey(serviceCtx(), &proto.RotateApiKeyRequest{AccountId: revaID, Id: created.ApiKey.Id}, rotated))
+	assert.Equal(t, created.ApiKey.Id, rotated.ApiKey.Id)
+	assert.NotEqual(t, created.Key, rotated.Key)
+	assertCode(t, 401, authenticate(created.Key))
+	require.NoError(t, authenticate(rotated.Key))
+
+	// keys of disabled accounts are rejected
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: revaID, AccountEnabled: false},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+	}, &proto.Account{}))
+	assertCode(t, 401, authenticate(rotated.Key))
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: revaID, AccountEnabled: true},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+	}, &proto.Account{}))
+	require.NoError(t, authenticate(rotated.Key))
+
+	// revoked keys are rejected
+	require.NoError(t, svc.RevokeApiKey(serviceCtx(), &proto.RevokeApiKeyRequest{AccountId: revaID, Id: created.ApiKey.Id}, &empty.Empty{}))
+	assertCode(t, 401, authenticate(rotated.Key))
+	assertCode(t, 404, svc.RevokeApiKey(serviceCtx(), &proto.RevokeApiKeyRequest{AccountId: revaID, Id: created.ApiKey.Id}, &empty.Empty{}))
+}
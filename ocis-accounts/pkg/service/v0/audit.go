@@ -0,0 +1,184 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RemoteMetadataKey is the metadata key holding the address of the client. The go-micro grpc server sets it for
+// incoming requests, the http server has to set it for the web handlers.
+const RemoteMetadataKey = "Remote"
+
+// auditLock serializes writers and readers of the audit log
+var auditLock sync.Mutex
+
+// actorKey is the context key of the actor of operations the service performs as part of a request
+type actorKey struct{}
+
+// actor returns who is responsible for a request: the authenticated user, or the service calling on its own behalf.
+// The metadata of a request is not trusted, any caller can set it.
+func actor(ctx context.Context) string {
+	if a, ok := ctx.Value(actorKey{}).(string); ok {
+		return a
+	}
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if p.AccountID != "" {
+		return p.AccountID
+	}
+	return p.Service
+}
+
+// auditLogPath returns the path of the audit log
+func (s Service) auditLogPath() string {
+	if s.Config.Server.AuditLog != "" {
+		return s.Config.Server.AuditLog
+	}
+	return filepath.Join(s.Config.Server.AccountsDataPath, "audit.jsonl")
+}
+
+// audit appends a record for a successful mutation to the audit log and publishes the matching event. Only the changed
+// paths are recorded, never their values. Failing to write the record is logged, the mutation has already been
+// persisted.
+func (s Service) audit(ctx context.Context, action, targetType, targetID, memberID string, paths []string) {
+	r := &proto.AuditRecord{
+		Timestamp:  timestamppb.Now(),
+		Action:     action,
+		TargetType: targetType,
+		TargetId:   targetID,
+		MemberId:   memberID,
+		Paths:      paths,
+	}
+	r.Actor = actor(ctx)
+	if remote, ok := metadata.Get(ctx, RemoteMetadataKey); ok {
+		if host, _, err := net.SplitHostPort(remote); err == nil {
+			r.SourceIp = host
+		} else {
+			r.SourceIp = remote
+		}
+	}
+
+	if eventType, ok := eventTypes[targetType+"."+action]; ok {
+		s.publish(eventFromRecord(r, eventType))
+	}
+
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		s.log.Error().Err(err).Str("action", action).Str("id", targetID).Msg("could not marshal audit record")
+		return
+	}
+
+	auditLock.Lock()
+	defer auditLock.Unlock()
+
+	path := s.auditLogPath()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		s.log.Error().Err(err).Str("path", path).Msg("could not open audit log")
+		return
+	}
+	defer f.Close()
+	if _, err = f.Write(append(bytes, '\n')); err != nil {
+		s.log.Error().Err(err).Str("path", path).Str("action", action).Str("id", targetID).Msg("could not write audit record")
+	}
+}
+
+// updatedPaths returns the paths that are changed by an update with the given mask. An empty mask updates all
+// updatable paths.
+func updatedPaths(paths []string, updatablePaths map[string]struct{}) []string {
+	if len(paths) > 0 {
+		return append([]string{}, paths...)
+	}
+	all := make([]string, 0, len(updatablePaths))
+	for p := range updatablePaths {
+		all = append(all, p)
+	}
+	sort.Strings(all)
+	return all
+}
+
+// ListAuditRecords implements the AccountsServiceHandler interface
+func (s Service) ListAuditRecords(ctx context.Context, in *proto.ListAuditRecordsRequest, out *proto.ListAuditRecordsResponse) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.ListAuditRecords", trace.StringAttribute("actor", in.Actor), trace.StringAttribute("target_id", in.TargetId))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, AccountManagementPermissionID, "ListAuditRecords"); err != nil {
+		return
+	}
+	if in.PageSize < 0 {
+		return merrors.BadRequest(s.id, "page_size must not be negative")
+	}
+
+	auditLock.Lock()
+	defer auditLock.Unlock()
+
+	out.Records = []*proto.AuditRecord{}
+	path := s.auditLogPath()
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		s.log.Error().Err(err).Str("path", path).Msg("could not open audit log")
+		return merrors.InternalServerError(s.id, "could not open audit log: %v", err.Error())
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		r := &proto.AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), r); err != nil {
+			s.log.Error().Err(err).Str("path", path).Msg("could not unmarshal audit record, skipping")
+			continue
+		}
+		if auditRecordMatches(r, in) {
+			out.Records = append(out.Records, r)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		s.log.Error().Err(err).Str("path", path).Msg("could not read audit log")
+		return merrors.InternalServerError(s.id, "could not read audit log: %v", err.Error())
+	}
+
+	// most recent first
+	for i, j := 0, len(out.Records)-1; i < j; i, j = i+1, j-1 {
+		out.Records[i], out.Records[j] = out.Records[j], out.Records[i]
+	}
+	if in.PageSize > 0 && len(out.Records) > int(in.PageSize) {
+		out.Records = out.Records[:in.PageSize]
+	}
+	return nil
+}
+
+// auditRecordMatches checks if a record matches the filters of the request
+func auditRecordMatches(r *proto.AuditRecord, in *proto.ListAuditRecordsRequest) bool {
+	switch {
+	case in.Actor != "" && r.Actor != in.Actor:
+		return false
+	case in.Action != "" && r.Action != in.Action:
+		return false
+	case in.TargetId != "" && r.TargetId != in.TargetId:
+		return false
+	case in.Since != nil && r.Timestamp.AsTime().Before(in.Since.AsTime()):
+		return false
+	case in.Until != nil && !r.Timestamp.AsTime().Before(in.Until.AsTime()):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/micro/go-micro/v2/metadata"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const auditDataPath = "/var/tmp/ocis-accounts-audit-tests"
+
+func TestAudit(t *testing.T) {
+	defer os.RemoveAll(auditDataPath)
+
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = auditDataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	roleService := buildRoleServiceMock()
+	svc, err := New(Logger(olog.NewLogger()), Config(cfg), RoleService(roleService), RoleManager(buildRoleManager(roleService)))
+	require.NoError(t, err)
+
+	ctx := metadata.Set(delegatedCtx("058bff95-6708-4fe5-91e4-9ea3d377588b", ssvc.BundleUUIDRoleAdmin), RemoteMetadataKey, "192.0.2.1:54321")
+
+	a := &proto.Account{}
+	require.NoError(t, svc.CreateAccount(ctx, &proto.CreateAccountRequest{Account: &proto.Account{
+		PreferredName:            "audited",
+		OnPremisesSamAccountName: "audited",
+		Mail:                     "audited@example.org",
+		PasswordProfile:          &proto.PasswordProfile{Password: "Secret123!"},
+	}}, a))
+	require.NoError(t, svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: a.Id, DisplayName: "Audited", PasswordProfile: &proto.PasswordProfile{Password: "Changed123!"}},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName", "PasswordProfile.Password"}},
+	}, &proto.Account{}))
+	require.NoError(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa", AccountId: a.Id}, &proto.Group{}))
+	// services calling on their own behalf are the actor, the account in the metadata is not trusted
+	spoofed := metadata.Set(serviceCtx(), middleware.AccountID, "058bff95-6708-4fe5-91e4-9ea3d377588b")
+	require.NoError(t, svc.RemoveMember(spoofed, &proto.RemoveMemberRequest{GroupId: "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa", AccountId: a.Id}, &proto.Group{}))
+
+	out := &proto.ListAuditRecordsResponse{}
+	require.NoError(t, svc.ListAuditRecords(ctx, &proto.ListAuditRecordsRequest{}, out))
+	require.Len(t, out.Records, 4)
+	assert.Equal(t, "remove_member", out.Records[0].Action)
+	assert.Equal(t, "com.owncloud.api.test", out.Records[0].Actor)
+	assert.Empty(t, out.Records[0].SourceIp)
+
+	update := out.Records[2]
+	assert.Equal(t, "update", update.Action)
+	assert.Equal(t, "account", update.TargetType)
+	assert.Equal(t, a.Id, update.TargetId)
+	assert.Equal(t, "058bff95-6708-4fe5-91e4-9ea3d377588b", update.Actor)
+	assert.Equal(t, "192.0.2.1", update.SourceIp)
+	assert.Equal(t, []string{"DisplayName", "PasswordProfile.Password"}, update.Paths)
+	assert.NotNil(t, update.Timestamp)
+
+	out = &proto.ListAuditRecordsResponse{}
+	require.NoError(t, svc.ListAuditRecords(ctx, &proto.ListAuditRecordsRequest{Action: "add_member", TargetId: "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa"}, out))
+	require.Len(t, out.Records, 1)
+	assert.Equal(t, a.Id, out.Records[0].MemberId)
+
+	out = &proto.ListAuditRecordsResponse{}
+	require.NoError(t, svc.ListAuditRecords(ctx, &proto.ListAuditRecordsRequest{Actor: "058bff95-6708-4fe5-91e4-9ea3d377588b", PageSize: 1}, out))
+	require.Len(t, out.Records, 1)
+	assert.Equal(t, "add_member", out.Records[0].Action)
+
+	log, err := ioutil.ReadFile(filepath.Join(auditDataPath, "audit.jsonl"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(log), "Secret123!")
+	assert.NotContains(t, string(log), "Changed123!")
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"go.opencensus.io/trace"
+)
+
+// scopeAll is the resource id of scoped permissions that are not limited to a single group
+const scopeAll = "all"
+
+// access is what the caller of a request may do. It is collected from the permissions in the roles of the caller once
+// per request and checked against every record the request reads or changes.
+type access struct {
+	accountID string
+
+	readAccounts  bool
+	writeAccounts bool
+	readGroups    bool
+	writeGroups   bool
+
+	// accountScopes are the ids of the groups whose members may be managed
+	accountScopes []string
+	// memberScopes are the ids of the groups whose members may be added and removed
+	memberScopes []string
+}
+
+// grant adds a permission of the roles of the caller. Permissions limited to reading do not allow changes.
+func (a *access) grant(setting *settings.Setting) {
+	permission := setting.GetPermissionValue()
+	write := permission == nil || permission.Operation != settings.Permission_OPERATION_READ
+	scope := setting.GetResource().GetId()
+
+	switch setting.Id {
+	case AccountManagementPermissionID:
+		a.readAccounts = true
+		a.writeAccounts = a.writeAccounts || write
+	case GroupManagementPermissionID:
+		a.readGroups = true
+		a.writeGroups = a.writeGroups || write
+	case DirectoryReadPermissionID:
+		a.readAccounts = true
+		a.readGroups = true
+	case GroupMemberManagementPermissionID:
+		switch {
+		case !write:
+		case permission.GetConstraint() == settings.Permission_CONSTRAINT_OWN:
+			// owners manage the members of their groups without a permission
+		case scope != "":
+			a.memberScopes = append(a.memberScopes, scope)
+		}
+	case ScopedAccountManagementPermissionID:
+		if write && scope != "" {
+			a.accountScopes = append(a.accountScopes, scope)
+		}
+	}
+}
+
+// inScope checks if one of the group ids is covered by the scopes
+func inScope(scopes []string, groupIDs ...string) bool {
+	for _, scope := range scopes {
+		if scope == scopeAll {
+			return true
+		}
+		for _, id := range groupIDs {
+			if strings.EqualFold(scope, id) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mayReadAccounts checks if the caller may read any account
+func (a *access) mayReadAccounts() bool {
+	return a.readAccounts || len(a.accountScopes) > 0
+}
+
+// mayManageAccounts checks if the caller may change any account
+func (a *access) mayManageAccounts() bool {
+	return a.writeAccounts || len(a.accountScopes) > 0
+}
+
+// hasAccount checks if a user is calling, users may read their own account and change their profile
+func (a *access) hasAccount() bool {
+	return a.accountID != ""
+}
+
+// isSelf checks if the account is the one of the calling user
+func (a *access) isSelf(acc *proto.Account) bool {
+	return a.hasAccount() && a.accountID == acc.Id
+}
+
+// canReadAccount checks if the caller may read the account
+func (a *access) canReadAccount(acc *proto.Account) bool {
+	return a.readAccounts || a.isSelf(acc) || a.managesAccount(acc)
+}
+
+// managesAccount checks if the caller may change the account. Scoped administrators manage the members of their
+// groups.
+func (a *access) managesAccount(acc *proto.Account) bool {
+	if a.writeAccounts {
+		return true
+	}
+	groupIDs := make([]string, 0, len(acc.MemberOf))
+	for i := range acc.MemberOf {
+		groupIDs = append(groupIDs, acc.MemberOf[i].Id)
+	}
+	return inScope(a.accountScopes, groupIDs...)
+}
+
+// mayReadGroups checks if the caller may read any group
+func (a *access) mayReadGroups() bool {
+	return a.readGroups || a.writeGroups || len(a.memberScopes) > 0 || len(a.accountScopes) > 0
+}
+
+// mayReadOwnGroups checks if the caller may read any group or is a user, who may read the groups it owns
+func (a *access) mayReadOwnGroups() bool {
+	return a.mayReadGroups() || a.hasAccount()
+}
+
+// mayManageMembers checks if the caller may change the members of any group. Users may change the members of the
+// groups they own.
+func (a *access) mayManageMembers() bool {
+	return a.writeGroups || len(a.memberScopes) > 0 || a.hasAccount()
+}
+
+// canReadGroup checks if the caller may read the group. Scoped administrators may read the groups of their scope.
+func (a *access) canReadGroup(g *proto.Group) bool {
+	return a.readGroups || a.managesMembers(g) || inScope(a.accountScopes, g.Id)
+}
+
+// managesMembers checks if the caller may add and remove members of the group
+func (a *access) managesMembers(g *proto.Group) bool {
+	return a.writeGroups || inScope(a.memberScopes, g.Id) || a.isOwner(g)
+}
+
+// isOwner checks if the calling user owns the group
+func (a *access) isOwner(g *proto.Group) bool {
+	if !a.hasAccount() {
+		return false
+	}
+	for i := range g.Owners {
+		if g.Owners[i].Id == a.accountID {
+			return true
+		}
+	}
+	return false
+}
+
+// mayChangeMembership checks if the caller may add an account it manages to the group or remove it. Scoped
+// administrators may move accounts into and out of their scope groups.
+func (a *access) mayChangeMembership(g *proto.Group) bool {
+	return a.managesMembers(g) || inScope(a.accountScopes, g.Id)
+}
+
+// checkMemberships denies changes of the group memberships of an account the caller may not make. Scoped
+// administrators have to keep the account in their scope.
+func (s Service) checkMemberships(ctx context.Context, acl *access, method string, current, wanted []*proto.Group) error {
+	if acl.writeAccounts {
+		return nil
+	}
+	if !acl.managesAccount(&proto.Account{MemberOf: wanted}) {
+		return s.forbidden(method)
+	}
+
+	changed := map[string]bool{}
+	for i := range current {
+		changed[current[i].Id] = true
+	}
+	for i := range wanted {
+		if changed[wanted[i].Id] {
+			delete(changed, wanted[i].Id)
+		} else {
+			changed[wanted[i].Id] = true
+		}
+	}
+	for id := range changed {
+		g := &proto.Group{}
+		if err := s.loadGroup(ctx, id, g); err != nil {
+			return err
+		}
+		if !acl.mayChangeMembership(g) {
+			return s.forbidden(method)
+		}
+	}
+	return nil
+}
+
+// authorize denies requests of unauthenticated callers and of callers whose roles do not allow the method. The
+// returned access has to be checked against the records the request touches. Services calling on their own behalf
+// are trusted, services calling on behalf of a user get the permissions of the user.
+func (s Service) authorize(ctx context.Context, method string, allowed func(a *access) bool) (*access, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, merrors.Unauthorized(s.id, "authentication required for %s", method)
+	}
+	a := &access{accountID: p.AccountID}
+	if p.Service != "" && p.AccountID == "" && len(p.RoleIDs) == 0 {
+		a.readAccounts, a.writeAccounts, a.readGroups, a.writeGroups = true, true, true, true
+		return a, nil
+	}
+
+	if len(p.RoleIDs) > 0 {
+		if s.RoleManager == nil {
+			// users would silently lose all their permissions
+			return nil, merrors.InternalServerError(s.id, "no role manager configured to authorize %s", method)
+		}
+		// collect the permissions in the roles of the authenticated account
+		ctx, span := startSettingsSpan(ctx, "ListRoles", trace.StringAttribute("role_ids", strings.Join(p.RoleIDs, ",")))
+		roles := s.RoleManager.List(ctx, p.RoleIDs)
+		span.End()
+		for _, role := range roles {
+			for _, setting := range role.Settings {
+				a.grant(setting)
+			}
+		}
+	}
+	if !allowed(a) {
+		return nil, s.forbidden(method)
+	}
+	return a, nil
+}
+
+// checkPermission denies requests of callers without the full permission, it is used by methods that are not
+// delegated
+func (s Service) checkPermission(ctx context.Context, permissionID, method string) error {
+	_, err := s.authorize(ctx, method, func(a *access) bool {
+		if permissionID == GroupManagementPermissionID {
+			return a.writeGroups
+		}
+		return a.writeAccounts
+	})
+	return err
+}
+
+// forbidden is returned for records the caller may not access
+func (s Service) forbidden(method string) error {
+	return merrors.Forbidden(s.id, "no permission for %s", method)
+}
+
+// asService returns a context in which the service calls its own methods as part of an operation that has already
+// been authorized. The actor recorded in the audit log is kept.
+func (s Service) asService(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, actorKey{}, actor(ctx))
+	return auth.NewContext(ctx, auth.Principal{Service: s.id})
+}
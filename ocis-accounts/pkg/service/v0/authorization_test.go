@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/micro/go-micro/v2/server"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	authorizationDataPath = "/var/tmp/ocis-accounts-authorization-tests"
+
+	readerRoleID        = "test-reader-role"
+	scopedAdminRoleID   = "test-scoped-admin-role"
+	readOnlyAdminRoleID = "test-read-only-admin-role"
+	memberManagerRoleID = "test-member-manager-role"
+
+	einsteinID      = "4c510ada-c86b-4815-8820-42cdf82c3d51"
+	marieID         = "f7fbf8c8-139b-4376-b307-cf0a8c2d0d9c"
+	usersID         = "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa"
+	radiumLoversID  = "7b87fd49-286e-4a5f-bafd-c535d5dd997a"
+	sailingLoversID = "6040aa17-9c64-4fef-9bd0-77234d71bad0"
+	violinHatersID  = "dd58e5ec-842e-498b-8800-61f2ec6f911f"
+	physicsLoversID = "262982c1-2362-4afa-bfdf-8cbfef64a06e"
+	richardID       = "932b4540-8d16-481e-8ef4-588e4b6b151c"
+)
+
+func newAuthorizationService(t *testing.T) *Service {
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = authorizationDataPath
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	cfg.Bootstrap.DemoUsers = true
+	roleService := buildRoleServiceMock()
+	svc, err := New(Logger(olog.NewLogger()), Config(cfg), RoleService(roleService), RoleManager(buildRoleManager(roleService)))
+	require.NoError(t, err)
+	return svc
+}
+
+func accountIDs(accounts []*proto.Account) []string {
+	ids := []string{}
+	for _, a := range accounts {
+		ids = append(ids, a.Id)
+	}
+	return ids
+}
+
+func groupIDs(groups []*proto.Group) []string {
+	ids := []string{}
+	for _, g := range groups {
+		ids = append(ids, g.Id)
+	}
+	return ids
+}
+
+func assertForbidden(t *testing.T, err error) {
+	require.Error(t, err)
+	assert.EqualValues(t, 403, merrors.FromError(err).GetCode(), err.Error())
+}
+
+func TestDirectoryRead(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	for _, roleID := range []string{readerRoleID, readOnlyAdminRoleID} {
+		ctx := buildTestCtx(t, []string{roleID})
+
+		accounts := &proto.ListAccountsResponse{}
+		require.NoError(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{}, accounts))
+		assert.Contains(t, accountIDs(accounts.Accounts), richardID)
+		require.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: marieID}, &proto.Account{}))
+
+		assertForbidden(t, svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+			Account:    &proto.Account{Id: marieID, DisplayName: "Marie"},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName"}},
+		}, &proto.Account{}))
+		assertForbidden(t, svc.DeleteAccount(ctx, &proto.DeleteAccountRequest{Id: marieID}, nil))
+		assertForbidden(t, svc.ListAuditRecords(ctx, &proto.ListAuditRecordsRequest{}, &proto.ListAuditRecordsResponse{}))
+	}
+
+	ctx := buildTestCtx(t, []string{readerRoleID})
+	groups := &proto.ListGroupsResponse{}
+	require.NoError(t, svc.ListGroups(ctx, &proto.ListGroupsRequest{}, groups))
+	assert.Contains(t, groupIDs(groups.Groups), violinHatersID)
+	require.NoError(t, svc.ListMembers(ctx, &proto.ListMembersRequest{Id: physicsLoversID}, &proto.ListMembersResponse{}))
+	assertForbidden(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: violinHatersID, AccountId: marieID}, &proto.Group{}))
+	assertForbidden(t, svc.CreateGroup(ctx, &proto.CreateGroupRequest{Group: &proto.Group{DisplayName: "readers"}}, &proto.Group{}))
+}
+
+func TestScopedAccountManagement(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	ctx := buildTestCtx(t, []string{scopedAdminRoleID})
+
+	// only the members of the radium lovers are visible
+	accounts := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{}, accounts))
+	assert.Equal(t, []string{marieID}, accountIDs(accounts.Accounts))
+	require.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: marieID}, &proto.Account{}))
+	assertForbidden(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: einsteinID}, &proto.Account{}))
+
+	groups := &proto.ListGroupsResponse{}
+	require.NoError(t, svc.ListGroups(ctx, &proto.ListGroupsRequest{}, groups))
+	assert.Equal(t, []string{radiumLoversID}, groupIDs(groups.Groups))
+
+	rename := func(id string) error {
+		return svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+			Account:    &proto.Account{Id: id, DisplayName: "Renamed"},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName"}},
+		}, &proto.Account{})
+	}
+	require.NoError(t, rename(marieID))
+	assertForbidden(t, rename(einsteinID))
+
+	// accounts can only be created in the scope and must not be moved out of it or into other groups
+	newAccount := func(name string, memberOf ...*proto.Group) error {
+		return svc.CreateAccount(ctx, &proto.CreateAccountRequest{Account: &proto.Account{
+			PreferredName:            name,
+			OnPremisesSamAccountName: name,
+			Mail:                     name + "@example.org",
+			MemberOf:                 memberOf,
+		}}, &proto.Account{})
+	}
+	assertForbidden(t, newAccount("pierre"))
+	assertForbidden(t, newAccount("pierre", &proto.Group{Id: radiumLoversID}, &proto.Group{Id: violinHatersID}))
+	require.NoError(t, newAccount("irene", &proto.Group{Id: radiumLoversID}))
+
+	move := func(groups ...*proto.Group) error {
+		return svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+			Account:    &proto.Account{Id: marieID, MemberOf: groups},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"MemberOf"}},
+		}, &proto.Account{})
+	}
+	assertForbidden(t, move(&proto.Group{Id: usersID}))
+	assertForbidden(t, move(&proto.Group{Id: radiumLoversID}, &proto.Group{Id: violinHatersID}))
+
+	assertForbidden(t, svc.DeleteAccount(ctx, &proto.DeleteAccountRequest{Id: einsteinID}, nil))
+	require.NoError(t, svc.DeleteAccount(ctx, &proto.DeleteAccountRequest{Id: marieID}, nil))
+	require.NoError(t, svc.RestoreAccount(ctx, &proto.RestoreAccountRequest{Id: marieID}, &proto.Account{}))
+
+	assertForbidden(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: radiumLoversID, AccountId: einsteinID}, &proto.Group{}))
+	assertForbidden(t, svc.SyncAccounts(ctx, &proto.SyncAccountsRequest{}, &proto.SyncAccountsResponse{}))
+}
+
+func TestGroupOwnerMemberManagement(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	ctx := buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser})
+
+	// only group administrators make einstein an owner of the sailing lovers
+	assertForbidden(t, svc.AddOwner(ctx, &proto.AddOwnerRequest{GroupId: sailingLoversID, AccountId: einsteinID}, &proto.Group{}))
+	require.NoError(t, svc.AddOwner(serviceCtx(), &proto.AddOwnerRequest{GroupId: sailingLoversID, AccountId: einsteinID}, &proto.Group{}))
+
+	groups := &proto.ListGroupsResponse{}
+	require.NoError(t, svc.ListOwnedGroups(ctx, &proto.ListOwnedGroupsRequest{}, groups))
+	assert.Equal(t, []string{sailingLoversID}, groupIDs(groups.Groups))
+	assertForbidden(t, svc.ListOwnedGroups(ctx, &proto.ListOwnedGroupsRequest{AccountId: marieID}, &proto.ListGroupsResponse{}))
+	assertForbidden(t, svc.ListGroups(ctx, &proto.ListGroupsRequest{}, &proto.ListGroupsResponse{}))
+
+	require.NoError(t, svc.GetGroup(ctx, &proto.GetGroupRequest{Id: sailingLoversID}, &proto.Group{}))
+	assertForbidden(t, svc.GetGroup(ctx, &proto.GetGroupRequest{Id: violinHatersID}, &proto.Group{}))
+	owners := &proto.ListOwnersResponse{}
+	require.NoError(t, svc.ListOwners(ctx, &proto.ListOwnersRequest{Id: sailingLoversID}, owners))
+	assert.Equal(t, []string{einsteinID}, accountIDs(owners.Owners))
+
+	// owners only add the accounts they manage, the group might be the scope of an administrator
+	assertForbidden(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: sailingLoversID, AccountId: marieID}, &proto.Group{}))
+	require.NoError(t, svc.AddMember(serviceCtx(), &proto.AddMemberRequest{GroupId: sailingLoversID, AccountId: marieID}, &proto.Group{}))
+	members := &proto.ListMembersResponse{}
+	require.NoError(t, svc.ListMembers(ctx, &proto.ListMembersRequest{Id: sailingLoversID}, members))
+	assert.Contains(t, accountIDs(members.Members), marieID)
+	require.NoError(t, svc.RemoveMember(ctx, &proto.RemoveMemberRequest{GroupId: sailingLoversID, AccountId: marieID}, &proto.Group{}))
+
+	assertForbidden(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: violinHatersID, AccountId: marieID}, &proto.Group{}))
+	assertForbidden(t, svc.RemoveMember(ctx, &proto.RemoveMemberRequest{GroupId: violinHatersID, AccountId: einsteinID}, &proto.Group{}))
+	assertForbidden(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{}, &proto.ListAccountsResponse{}))
+
+	// owners only change the description
+	describe := func(id string, paths ...string) error {
+		return svc.UpdateGroup(ctx, &proto.UpdateGroupRequest{
+			Group:      &proto.Group{Id: id, DisplayName: "sailors", Description: "Sailing lovers"},
+			UpdateMask: &field_mask.FieldMask{Paths: paths},
+		}, &proto.Group{})
+	}
+	require.NoError(t, describe(sailingLoversID, "Description"))
+	err := describe(sailingLoversID, "DisplayName")
+	require.Error(t, err)
+	assert.EqualValues(t, 400, merrors.FromError(err).GetCode(), err.Error())
+	assertForbidden(t, describe(violinHatersID, "Description"))
+
+	require.NoError(t, svc.RemoveOwner(serviceCtx(), &proto.RemoveOwnerRequest{GroupId: sailingLoversID, AccountId: einsteinID}, &proto.Group{}))
+	assertForbidden(t, svc.RemoveMember(ctx, &proto.RemoveMemberRequest{GroupId: sailingLoversID, AccountId: richardID}, &proto.Group{}))
+	groups = &proto.ListGroupsResponse{}
+	require.NoError(t, svc.ListOwnedGroups(ctx, &proto.ListOwnedGroupsRequest{}, groups))
+	assert.Empty(t, groups.Groups)
+}
+
+func TestScopedMemberManagement(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	// the radium lovers are the scope of the scoped administrators, their member managers must not pull accounts in
+	memberManager := buildTestCtx(t, []string{memberManagerRoleID})
+	assertForbidden(t, svc.AddMember(memberManager, &proto.AddMemberRequest{GroupId: radiumLoversID, AccountId: einsteinID}, &proto.Group{}))
+	scopedAdmin := buildTestCtx(t, []string{memberManagerRoleID, scopedAdminRoleID})
+	assertForbidden(t, svc.AddMember(scopedAdmin, &proto.AddMemberRequest{GroupId: radiumLoversID, AccountId: einsteinID}, &proto.Group{}))
+	require.NoError(t, svc.AddMember(scopedAdmin, &proto.AddMemberRequest{GroupId: radiumLoversID, AccountId: marieID}, &proto.Group{}))
+
+	// members and owners of new groups are checked like with AddMember before the group is written
+	memberOf := func(accountID string) []string {
+		a := &proto.Account{}
+		require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: accountID}, a))
+		return groupIDs(a.MemberOf)
+	}
+	before := memberOf(marieID)
+	assertCode(t, 404, svc.CreateGroup(serviceCtx(), &proto.CreateGroupRequest{Group: &proto.Group{
+		Id:                       "chemists",
+		DisplayName:              "chemists",
+		OnPremisesSamAccountName: "chemists",
+		Members:                  []*proto.Account{{Id: "unknown"}},
+	}}, &proto.Group{}))
+	assertCode(t, 404, svc.CreateGroup(serviceCtx(), &proto.CreateGroupRequest{Group: &proto.Group{
+		Id:                       "chemists",
+		DisplayName:              "chemists",
+		OnPremisesSamAccountName: "chemists",
+		Members:                  []*proto.Account{{Id: marieID}},
+		Owners:                   []*proto.Account{{Id: "unknown"}},
+	}}, &proto.Group{}))
+	assertCode(t, 404, svc.GetGroup(serviceCtx(), &proto.GetGroupRequest{Id: "chemists"}, &proto.Group{}))
+	assert.Equal(t, before, memberOf(marieID))
+
+	// the lifecycle metadata of new groups is set by the service
+	g := &proto.Group{}
+	require.NoError(t, svc.CreateGroup(serviceCtx(), &proto.CreateGroupRequest{Group: &proto.Group{
+		Id:                       "chemists",
+		DisplayName:              "chemists",
+		OnPremisesSamAccountName: "chemists",
+		Members:                  []*proto.Account{{Id: marieID}, {Id: marieID}},
+		Owners:                   []*proto.Account{{Id: einsteinID}},
+		CreatedDateTime:          timestamppb.New(time.Now().Add(-time.Hour)),
+		DeletedDateTime:          timestamppb.Now(),
+	}}, g))
+	assert.WithinDuration(t, time.Now(), g.CreatedDateTime.AsTime(), time.Minute)
+	assert.Nil(t, g.DeletedDateTime)
+	assert.Equal(t, []string{marieID}, accountIDs(g.Members))
+	assert.Contains(t, memberOf(marieID), g.Id)
+	owners := &proto.ListOwnersResponse{}
+	require.NoError(t, svc.ListOwners(serviceCtx(), &proto.ListOwnersRequest{Id: g.Id}, owners))
+	assert.Equal(t, []string{einsteinID}, accountIDs(owners.Owners))
+}
+
+func TestDelegatedCall(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	token, err := auth.NewToken("secret", "com.owncloud.api.proxy")
+	require.NoError(t, err)
+
+	// the request passes the handler wrapper of the grpc server like a call of the proxy on behalf of einstein
+	list := func(md map[string]string) error {
+		ctx := metadata.Set(context.Background(), auth.TokenMetadataKey, token)
+		for k, v := range md {
+			ctx = metadata.Set(ctx, k, v)
+		}
+		handler := auth.HandlerWrapper("secret", false)(func(ctx context.Context, req server.Request, rsp interface{}) error {
+			return svc.ListAccounts(ctx, &proto.ListAccountsRequest{}, rsp.(*proto.ListAccountsResponse))
+		})
+		return handler(ctx, nil, &proto.ListAccountsResponse{})
+	}
+
+	require.NoError(t, list(nil))
+	require.NoError(t, list(map[string]string{middleware.AccountID: einsteinID, middleware.RoleIDs: `["` + ssvc.BundleUUIDRoleAdmin + `"]`}))
+	assertForbidden(t, list(map[string]string{middleware.AccountID: einsteinID, middleware.RoleIDs: `["` + ssvc.BundleUUIDRoleUser + `"]`}))
+	// users without roles do not get the permissions of the service
+	assertForbidden(t, list(map[string]string{middleware.AccountID: einsteinID}))
+}
+
+func TestSelfService(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	ctx := buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser})
+
+	// the own account is found without its id
+	acc := &proto.Account{}
+	require.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{}, acc))
+	assert.Equal(t, einsteinID, acc.Id)
+	require.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: einsteinID}, &proto.Account{}))
+	assertForbidden(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: marieID}, &proto.Account{}))
+	assertForbidden(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{}, &proto.ListAccountsResponse{}))
+
+	update := func(a *proto.Account, oldPassword string, paths ...string) error {
+		return svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+			Account:     a,
+			UpdateMask:  &field_mask.FieldMask{Paths: paths},
+			OldPassword: oldPassword,
+		}, &proto.Account{})
+	}
+	require.NoError(t, update(&proto.Account{DisplayName: "Albert", PreferredLanguage: "de"}, "", "DisplayName", "PreferredLanguage"))
+	require.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{}, acc))
+	assert.Equal(t, "Albert", acc.DisplayName)
+	assert.Equal(t, "de", acc.PreferredLanguage)
+
+	// admin only fields stay protected
+	err := update(&proto.Account{Mail: "albert@example.org"}, "", "Mail")
+	require.Error(t, err)
+	assert.EqualValues(t, 400, merrors.FromError(err).GetCode(), err.Error())
+	assertForbidden(t, update(&proto.Account{MemberOf: []*proto.Group{{Id: usersID}}}, "", "MemberOf"))
+	assertForbidden(t, update(&proto.Account{Id: marieID, DisplayName: "Marie"}, "", "DisplayName"))
+
+	// the password is only changed with the old one
+	password := func(oldPassword string) error {
+		return update(&proto.Account{PasswordProfile: &proto.PasswordProfile{Password: "E=mc^2"}}, oldPassword, "PasswordProfile.Password")
+	}
+	assertForbidden(t, password(""))
+	assertForbidden(t, password("wrong"))
+	require.NoError(t, password("relativity"))
+	assertForbidden(t, password("relativity"))
+	require.NoError(t, password("E=mc^2"))
+}
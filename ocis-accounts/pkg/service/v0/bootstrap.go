@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/gofrs/uuid"
+	"github.com/owncloud/ocis-pkg/v2/log"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	settings_svc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/tredoe/osutil/user/crypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bootstrapMarker is the file in the accounts data path recording that the data path has been initialized
+const bootstrapMarker = ".bootstrapped"
+
+// bootstrapInProgress is the file in the accounts data path recording that the initialization has started. It is
+// renamed to the bootstrapMarker once all records have been written.
+const bootstrapInProgress = ".bootstrapping"
+
+// adminName is the name of the initial admin account
+const adminName = "admin"
+
+// the settings service assigns the roles of the seed, it might still be starting when the accounts service is
+var (
+	roleAssignmentAttempts = 10
+	roleAssignmentDelay    = time.Second
+)
+
+// seedRoles maps the role names that can be used in seeds to the ids of the roles
+var seedRoles = map[string]string{
+	"admin": settings_svc.BundleUUIDRoleAdmin,
+	"user":  settings_svc.BundleUUIDRoleUser,
+	"guest": settings_svc.BundleUUIDRoleGuest,
+}
+
+// Seed holds the accounts and groups a new accounts data path is initialized with. Seed files are YAML or JSON
+// documents using the property names of the account and group messages, the demo seed is an example.
+type Seed struct {
+	Accounts []*SeedAccount `json:"accounts"`
+	Groups   []*proto.Group `json:"groups"`
+}
+
+// SeedAccount is an account of a seed. The password profile holds a crypt hash, a clear text password is hashed when
+// the account is created. Roles are role ids or the names admin, user and guest.
+type SeedAccount struct {
+	*proto.Account
+	Password string   `json:"password,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+}
+
+// loadSeed returns the seed of the configuration. Without a seed file or the demo users it is empty.
+func loadSeed(cfg config.Bootstrap) (*Seed, error) {
+	var data []byte
+	switch {
+	case cfg.DemoUsers && cfg.SeedFile != "":
+		return nil, errors.New("the demo users can not be combined with a seed file")
+	case cfg.DemoUsers:
+		data = []byte(demoSeed)
+	case cfg.SeedFile != "":
+		var err error
+		if data, err = ioutil.ReadFile(cfg.SeedFile); err != nil {
+			return nil, fmt.Errorf("could not read seed file: %w", err)
+		}
+	default:
+		return &Seed{}, nil
+	}
+
+	seed := &Seed{}
+	if err := yaml.Unmarshal(data, seed); err != nil {
+		return nil, fmt.Errorf("could not parse seed: %w", err)
+	}
+	for i, a := range seed.Accounts {
+		if a == nil || a.Account == nil {
+			return nil, fmt.Errorf("account %d of the seed is empty", i)
+		}
+		if a.Id == "" {
+			a.Id = uuid.Must(uuid.NewV4()).String()
+		}
+	}
+	for i, g := range seed.Groups {
+		if g == nil {
+			return nil, fmt.Errorf("group %d of the seed is empty", i)
+		}
+		if g.Id == "" {
+			g.Id = uuid.Must(uuid.NewV4()).String()
+		}
+	}
+	return seed, nil
+}
+
+// link completes the memberships, they can be listed on the accounts, on the groups or on both. Accounts and groups
+// are referenced by id or by on_premises_sam_account_name.
+func (seed *Seed) link() error {
+	accounts := map[string]*SeedAccount{}
+	for _, a := range seed.Accounts {
+		accounts[a.Id] = a
+		if a.OnPremisesSamAccountName != "" {
+			accounts[strings.ToLower(a.OnPremisesSamAccountName)] = a
+		}
+	}
+	groups := map[string]*proto.Group{}
+	for _, g := range seed.Groups {
+		groups[g.Id] = g
+		if g.OnPremisesSamAccountName != "" {
+			groups[strings.ToLower(g.OnPremisesSamAccountName)] = g
+		}
+	}
+
+	type membership struct {
+		account *SeedAccount
+		group   *proto.Group
+	}
+	seen := map[[2]string]bool{}
+	memberships := []membership{}
+	add := func(a *SeedAccount, g *proto.Group) {
+		if key := [2]string{a.Id, g.Id}; !seen[key] {
+			seen[key] = true
+			memberships = append(memberships, membership{a, g})
+		}
+	}
+	for _, a := range seed.Accounts {
+		for _, ref := range a.MemberOf {
+			g := groups[ref.Id]
+			if g == nil {
+				g = groups[strings.ToLower(ref.OnPremisesSamAccountName)]
+			}
+			if g == nil {
+				return fmt.Errorf("account %s is a member of an unknown group", a.Id)
+			}
+			add(a, g)
+		}
+	}
+	for _, g := range seed.Groups {
+		for _, ref := range g.Members {
+			a := accounts[ref.Id]
+			if a == nil {
+				a = accounts[strings.ToLower(ref.OnPremisesSamAccountName)]
+			}
+			if a == nil {
+				return fmt.Errorf("group %s has an unknown member", g.Id)
+			}
+			add(a, g)
+		}
+	}
+
+	// only the ids of the memberships are stored
+	for _, a := range seed.Accounts {
+		a.MemberOf = nil
+	}
+	for _, g := range seed.Groups {
+		g.Members = nil
+	}
+	for _, m := range memberships {
+		m.account.MemberOf = append(m.account.MemberOf, &proto.Group{Id: m.group.Id})
+		m.group.Members = append(m.group.Members, &proto.Account{Id: m.account.Id})
+	}
+	return nil
+}
+
+// hasAdmin checks if an account of the seed gets the admin role
+func (seed *Seed) hasAdmin() bool {
+	for _, a := range seed.Accounts {
+		for _, role := range a.Roles {
+			if role == "admin" || role == settings_svc.BundleUUIDRoleAdmin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addAdmin adds the initial admin account. Without a configured password a random one is generated, which has to be
+// changed at the first sign-in.
+func (seed *Seed) addAdmin(cfg *config.Config) (password string, generated bool, err error) {
+	uid := cfg.Posix.UID.Lower
+	for _, a := range seed.Accounts {
+		if strings.EqualFold(a.OnPremisesSamAccountName, adminName) {
+			return "", false, fmt.Errorf("the seed has an account named %s without the admin role", adminName)
+		}
+		if a.UidNumber >= uid {
+			uid = a.UidNumber + 1
+		}
+	}
+
+	password = cfg.Bootstrap.AdminPassword
+	if password == "" {
+		if password, err = randomSecret(18); err != nil {
+			return "", false, fmt.Errorf("could not generate the admin password: %w", err)
+		}
+		generated = true
+	}
+
+	seed.Accounts = append(seed.Accounts, &SeedAccount{
+		Account: &proto.Account{
+			Id:                       uuid.Must(uuid.NewV4()).String(),
+			PreferredName:            adminName,
+			OnPremisesSamAccountName: adminName,
+			DisplayName:              "Administrator",
+			UidNumber:                uid,
+			GidNumber:                cfg.Posix.DefaultGID,
+			AccountEnabled:           true,
+			PasswordProfile: &proto.PasswordProfile{
+				ForceChangePasswordNextSignIn: generated,
+			},
+		},
+		Password: password,
+		Roles:    []string{"admin"},
+	})
+	return password, generated, nil
+}
+
+// assignSeedRole assigns a role to an account of the seed, it retries while the settings service is not available
+func assignSeedRole(ctx context.Context, accountID, roleID string, roleService settings.RoleService, logger log.Logger) error {
+	for attempt := 1; !assignRoleToUser(ctx, accountID, roleID, roleService, logger); attempt++ {
+		if attempt == roleAssignmentAttempts {
+			return fmt.Errorf("could not assign role %s to account %s", roleID, accountID)
+		}
+		time.Sleep(roleAssignmentDelay)
+	}
+	return nil
+}
+
+// bootstrap initializes a new accounts data path with the records of the seed and an initial admin account, unless
+// an account of the seed is an admin. It runs once, a marker file records that the data path has been initialized.
+// An initialization that has been interrupted is done again from scratch on the next start.
+func bootstrap(ctx context.Context, cfg *config.Config, roleService settings.RoleService, logger log.Logger) (err error) {
+	ctx, span := startSpan(ctx, "bootstrap")
+	defer func() { endSpan(span, err) }()
+
+	accountsDir := filepath.Join(cfg.Server.AccountsDataPath, "accounts")
+	groupsDir := filepath.Join(cfg.Server.AccountsDataPath, "groups")
+	marker := filepath.Join(cfg.Server.AccountsDataPath, bootstrapMarker)
+	inProgress := filepath.Join(cfg.Server.AccountsDataPath, bootstrapInProgress)
+	if _, err = os.Stat(marker); err == nil || !os.IsNotExist(err) {
+		return
+	}
+
+	_, err = os.Stat(inProgress)
+	switch {
+	case err == nil:
+		// the records of an interrupted initialization are incomplete and the admin password has not been shown
+		logger.Warn().Str("path", cfg.Server.AccountsDataPath).Msg("initializing the accounts data path has been interrupted, starting over")
+		for _, dir := range []string{accountsDir, groupsDir} {
+			if err = os.RemoveAll(dir); err != nil {
+				return
+			}
+		}
+	case !os.IsNotExist(err):
+		return
+	default:
+		// data paths created by earlier versions have been initialized with the demo users
+		if _, err = os.Stat(accountsDir); err == nil {
+			logger.Info().Str("path", cfg.Server.AccountsDataPath).Msg("accounts data path has already been initialized")
+			if err = os.MkdirAll(groupsDir, 0700); err != nil {
+				return
+			}
+			return ioutil.WriteFile(marker, nil, 0600)
+		}
+	}
+
+	// the seed is checked before anything is written
+	var seed *Seed
+	if seed, err = loadSeed(cfg.Bootstrap); err != nil {
+		return
+	}
+	var password string
+	var generated bool
+	if !seed.hasAdmin() {
+		if password, generated, err = seed.addAdmin(cfg); err != nil {
+			return
+		}
+	}
+	if err = seed.link(); err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(cfg.Server.AccountsDataPath, 0700); err != nil {
+		return
+	}
+	if err = ioutil.WriteFile(inProgress, nil, 0600); err != nil {
+		return
+	}
+	for _, dir := range []string{accountsDir, groupsDir} {
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return
+		}
+	}
+
+	now := timestamppb.Now()
+	for _, a := range seed.Accounts {
+		path := filepath.Join(accountsDir, a.Id)
+		if _, err = os.Stat(path); err == nil {
+			continue
+		}
+		if a.Password != "" {
+			if a.PasswordProfile == nil {
+				a.PasswordProfile = &proto.PasswordProfile{}
+			}
+			c := crypt.New(crypt.SHA512)
+			if a.PasswordProfile.Password, err = c.Generate([]byte(a.Password), nil); err != nil {
+				return fmt.Errorf("could not hash the password of account %s: %w", a.Id, err)
+			}
+		}
+		a.CreatedDateTime = now
+		a.LastModifiedDateTime = now
+
+		var bytes []byte
+		if bytes, err = json.Marshal(a.Account); err != nil {
+			return fmt.Errorf("could not marshal account %s: %w", a.Id, err)
+		}
+		if err = ioutil.WriteFile(path, bytes, 0600); err != nil {
+			return fmt.Errorf("could not persist account %s: %w", a.Id, err)
+		}
+		for _, role := range a.Roles {
+			if id, ok := seedRoles[role]; ok {
+				role = id
+			}
+			// without the role the account can not be used, e.g. the admin could not manage any account
+			if err = assignSeedRole(ctx, a.Id, role, roleService, logger); err != nil {
+				return
+			}
+		}
+	}
+
+	for _, g := range seed.Groups {
+		path := filepath.Join(groupsDir, g.Id)
+		if _, err = os.Stat(path); err == nil {
+			continue
+		}
+		g.CreatedDateTime = now
+		var bytes []byte
+		if bytes, err = json.Marshal(g); err != nil {
+			return fmt.Errorf("could not marshal group %s: %w", g.Id, err)
+		}
+		if err = ioutil.WriteFile(path, bytes, 0600); err != nil {
+			return fmt.Errorf("could not persist group %s: %w", g.Id, err)
+		}
+	}
+
+	if err = os.Rename(inProgress, marker); err != nil {
+		return
+	}
+	logger.Info().Int("accounts", len(seed.Accounts)).Int("groups", len(seed.Groups)).Msg("initialized accounts data path")
+
+	// the generated password is only shown once, it is not logged
+	if generated {
+		fmt.Fprintf(os.Stderr, "\nThe password of the initial %s account is: %s\nIt will not be shown again and has to be changed at the first sign-in.\n\n", adminName, password)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bootstrapDataPath = "/var/tmp/ocis-accounts-bootstrap-tests"
+
+const testSeed = `
+accounts:
+  - preferred_name: ada
+    on_premises_sam_account_name: ada
+    mail: ada@example.org
+    uid_number: 20100
+    password: analytical
+    roles: [user]
+groups:
+  - id: 0b4c1e2a-6f6e-4d5a-9d6b-8f0e3c9a7b11
+    on_premises_sam_account_name: engines
+    gid_number: 30100
+    members:
+      - on_premises_sam_account_name: ada
+`
+
+// newBootstrapService starts a service and records the roles it assigns
+func newBootstrapService(t *testing.T, bootstrap config.Bootstrap, assigned map[string]string) (*Service, error) {
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = bootstrapDataPath
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	cfg.Bootstrap = bootstrap
+	roleService := settings.MockRoleService{
+		AssignRoleToUserFunc: func(ctx context.Context, req *settings.AssignRoleToUserRequest, opts ...client.CallOption) (*settings.AssignRoleToUserResponse, error) {
+			assigned[req.AccountUuid] = req.RoleId
+			return &settings.AssignRoleToUserResponse{Assignment: &settings.UserRoleAssignment{}}, nil
+		},
+	}
+	return New(Logger(olog.NewLogger()), Config(cfg), RoleService(roleService))
+}
+
+// findAccount returns the account with the given name
+func findAccount(t *testing.T, svc *Service, name string) *proto.Account {
+	out := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{Query: "on_premises_sam_account_name eq '" + name + "'"}, out))
+	require.Len(t, out.Accounts, 1)
+	return out.Accounts[0]
+}
+
+// signIn checks the password of the account with the given name
+func signIn(svc *Service, name, password string) error {
+	return svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{Query: "login eq '" + name + "' and password eq '" + password + "'"}, &proto.ListAccountsResponse{})
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	defer os.RemoveAll(bootstrapDataPath)
+
+	assigned := map[string]string{}
+	svc, err := newBootstrapService(t, config.Bootstrap{}, assigned)
+	require.NoError(t, err)
+
+	// only the admin is created, its generated password has to be changed
+	accounts := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{}, accounts))
+	require.Len(t, accounts.Accounts, 1)
+	admin := accounts.Accounts[0]
+	assert.Equal(t, "admin", admin.OnPremisesSamAccountName)
+	assert.EqualValues(t, 20000, admin.UidNumber)
+	assert.True(t, admin.PasswordProfile.ForceChangePasswordNextSignIn)
+	assert.Equal(t, map[string]string{admin.Id: ssvc.BundleUUIDRoleAdmin}, assigned)
+
+	// restarting does not create records again
+	assigned = map[string]string{}
+	svc, err = newBootstrapService(t, config.Bootstrap{AdminPassword: "ignored"}, assigned)
+	require.NoError(t, err)
+	accounts = &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{}, accounts))
+	assert.Equal(t, []string{admin.Id}, accountIDs(accounts.Accounts))
+	assert.Empty(t, assigned)
+}
+
+func TestBootstrapSeedFile(t *testing.T) {
+	defer os.RemoveAll(bootstrapDataPath)
+
+	seedFile := filepath.Join(os.TempDir(), "ocis-accounts-seed.yaml")
+	require.NoError(t, ioutil.WriteFile(seedFile, []byte(testSeed), 0600))
+	defer os.Remove(seedFile)
+
+	_, err := newBootstrapService(t, config.Bootstrap{SeedFile: seedFile, DemoUsers: true}, map[string]string{})
+	require.Error(t, err)
+
+	assigned := map[string]string{}
+	svc, err := newBootstrapService(t, config.Bootstrap{SeedFile: seedFile, AdminPassword: "Secret123!"}, assigned)
+	require.NoError(t, err)
+
+	ada := findAccount(t, svc, "ada")
+	assert.NoError(t, signIn(svc, "ada", "analytical"))
+	assert.Equal(t, []string{"0b4c1e2a-6f6e-4d5a-9d6b-8f0e3c9a7b11"}, groupIDs(ada.MemberOf))
+	assert.Equal(t, ssvc.BundleUUIDRoleUser, assigned[ada.Id])
+
+	members := &proto.ListMembersResponse{}
+	require.NoError(t, svc.ListMembers(serviceCtx(), &proto.ListMembersRequest{Id: "0b4c1e2a-6f6e-4d5a-9d6b-8f0e3c9a7b11"}, members))
+	assert.Equal(t, []string{ada.Id}, accountIDs(members.Members))
+
+	// the admin gets the next free uid and the configured password
+	admin := findAccount(t, svc, "admin")
+	assert.EqualValues(t, 20101, admin.UidNumber)
+	assert.NoError(t, signIn(svc, "admin", "Secret123!"))
+	assert.Error(t, signIn(svc, "admin", "ignored"))
+	assert.False(t, admin.PasswordProfile.ForceChangePasswordNextSignIn)
+	assert.Equal(t, ssvc.BundleUUIDRoleAdmin, assigned[admin.Id])
+}
+
+func TestBootstrapExistingDataPath(t *testing.T) {
+	defer os.RemoveAll(bootstrapDataPath)
+
+	// data paths initialized before the bootstrap was introduced are left alone
+	require.NoError(t, os.MkdirAll(filepath.Join(bootstrapDataPath, "accounts"), 0700))
+	assigned := map[string]string{}
+	svc, err := newBootstrapService(t, config.Bootstrap{DemoUsers: true}, assigned)
+	require.NoError(t, err)
+
+	accounts := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{}, accounts))
+	assert.Empty(t, accounts.Accounts)
+	assert.Empty(t, assigned)
+	assert.FileExists(t, filepath.Join(bootstrapDataPath, bootstrapMarker))
+}
+
+func TestBootstrapInterrupted(t *testing.T) {
+	defer os.RemoveAll(bootstrapDataPath)
+
+	// the records of an interrupted initialization are replaced
+	require.NoError(t, os.MkdirAll(filepath.Join(bootstrapDataPath, "accounts"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(bootstrapDataPath, "accounts", "incomplete"), []byte("{"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(bootstrapDataPath, bootstrapInProgress), nil, 0600))
+	assigned := map[string]string{}
+	svc, err := newBootstrapService(t, config.Bootstrap{AdminPassword: "Secret123!"}, assigned)
+	require.NoError(t, err)
+
+	admin := findAccount(t, svc, "admin")
+	assert.NoError(t, signIn(svc, "admin", "Secret123!"))
+	assert.Equal(t, map[string]string{admin.Id: ssvc.BundleUUIDRoleAdmin}, assigned)
+	assert.NoFileExists(t, filepath.Join(bootstrapDataPath, "accounts", "incomplete"))
+	assert.NoFileExists(t, filepath.Join(bootstrapDataPath, bootstrapInProgress))
+	assert.FileExists(t, filepath.Join(bootstrapDataPath, bootstrapMarker))
+}
+
+func TestBootstrapRoleAssignmentFails(t *testing.T) {
+	defer os.RemoveAll(bootstrapDataPath)
+	defer func(delay time.Duration) { roleAssignmentDelay = delay }(roleAssignmentDelay)
+	roleAssignmentDelay = 0
+
+	// the initialization is not finished without the roles, it is done again on the next start
+	cfg := config.New()
+	cfg.Server.AccountsDataPath = bootstrapDataPath
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	cfg.Bootstrap = config.Bootstrap{AdminPassword: "Secret123!"}
+	attempts := 0
+	roleService := settings.MockRoleService{
+		AssignRoleToUserFunc: func(ctx context.Context, req *settings.AssignRoleToUserRequest, opts ...client.CallOption) (*settings.AssignRoleToUserResponse, error) {
+			attempts++
+			return nil, errors.New("settings service not available")
+		},
+	}
+	_, err := New(Logger(olog.NewLogger()), Config(cfg), RoleService(roleService))
+	require.Error(t, err)
+	assert.Equal(t, roleAssignmentAttempts, attempts)
+	assert.FileExists(t, filepath.Join(bootstrapDataPath, bootstrapInProgress))
+	assert.NoFileExists(t, filepath.Join(bootstrapDataPath, bootstrapMarker))
+
+	assigned := map[string]string{}
+	svc, err := newBootstrapService(t, config.Bootstrap{AdminPassword: "Secret123!"}, assigned)
+	require.NoError(t, err)
+	admin := findAccount(t, svc, "admin")
+	assert.Equal(t, map[string]string{admin.Id: ssvc.BundleUUIDRoleAdmin}, assigned)
+	assert.FileExists(t, filepath.Join(bootstrapDataPath, bootstrapMarker))
+}
@@ -0,0 +1,136 @@
+package service
+
+// demoSeed holds the demo users and groups, new data paths are initialized with them when the demo users are enabled.
+// It must never be used in production, the passwords of the users are publicly known.
+const demoSeed = `
+accounts:
+  - id: 4c510ada-c86b-4815-8820-42cdf82c3d51
+    preferred_name: einstein
+    on_premises_sam_account_name: einstein
+    mail: einstein@example.org
+    display_name: Albert Einstein
+    uid_number: 20000
+    gid_number: 30000
+    password_profile:
+      password: $6$rounds=35210$sa1u5Pmfo4cr23Vw$RJNGElaDB1D3xorWkfTEGm2Ko.o2QL3E0cimKx23MNxVWVFSkUUeRoC7FqC4RzYDNQBD6cKzovTEaDD.8TDkD.
+    account_enabled: true
+    memberOf:
+      - on_premises_sam_account_name: users
+      - on_premises_sam_account_name: sailing-lovers
+      - on_premises_sam_account_name: violin-haters
+      - on_premises_sam_account_name: physics-lovers
+    roles: [user]
+  - id: f7fbf8c8-139b-4376-b307-cf0a8c2d0d9c
+    preferred_name: marie
+    on_premises_sam_account_name: marie
+    mail: marie@example.org
+    display_name: Marie Curie
+    uid_number: 20001
+    gid_number: 30000
+    password_profile:
+      password: $6$rounds=81434$sa1u5Pmfo4cr23Vw$W78cyL884GmuvDpxYPvSRBVzEj02T5QhTTcI8Dv4IKvMooDFGv4bwaWMkH9HfJ0wgpEBW7Lp.4Cad0xE/MYSg1
+    account_enabled: true
+    memberOf:
+      - on_premises_sam_account_name: users
+      - on_premises_sam_account_name: radium-lovers
+      - on_premises_sam_account_name: polonium-lovers
+      - on_premises_sam_account_name: physics-lovers
+    roles: [user]
+  - id: 932b4540-8d16-481e-8ef4-588e4b6b151c
+    preferred_name: richard
+    on_premises_sam_account_name: richard
+    mail: richard@example.org
+    display_name: Richard Feynman
+    uid_number: 20002
+    gid_number: 30000
+    password_profile:
+      password: $6$rounds=5524$sa1u5Pmfo4cr23Vw$58bQVL/JeUlwM0RY21YKAFMvKvwKLLysGllYXox.vwKT5dHMwdzJjCxwTDMnB2o2pwexC8o/iOXyP2zrhALS40
+    account_enabled: true
+    memberOf:
+      - on_premises_sam_account_name: users
+      - on_premises_sam_account_name: quantum-lovers
+      - on_premises_sam_account_name: philosophy-haters
+      - on_premises_sam_account_name: physics-lovers
+    roles: [user]
+  # admin user
+  - id: 058bff95-6708-4fe5-91e4-9ea3d377588b
+    preferred_name: moss
+    on_premises_sam_account_name: moss
+    mail: moss@example.org
+    display_name: Maurice Moss
+    uid_number: 20003
+    gid_number: 30000
+    password_profile:
+      password: $6$rounds=47068$lhw6odzXW0LTk/ao$GgxS.pIgP8jawLJBAiyNor2FrWzrULF95PwspRkli2W3VF.4HEwTYlQfRXbNQBMjNCEcEYlgZo3a.kRz2k2N0/
+    account_enabled: true
+    memberOf:
+      - on_premises_sam_account_name: users
+    roles: [admin]
+  # service accounts for kopano and reva, they still bind with a password
+  - id: 820ba2a1-3f54-4538-80a4-2d73007e30bf
+    preferred_name: konnectd
+    on_premises_sam_account_name: konnectd
+    mail: idp@example.org
+    display_name: Kopano Konnectd
+    uid_number: 10000
+    gid_number: 15000
+    password_profile:
+      password: $6$rounds=9746$sa1u5Pmfo4cr23Vw$2hnwpkTvUkWX0v6mh8Aw1pbzEXa9EUJzmrey4g2W/8arwWCwhteqU//3aWnA3S0d5T21fOKYteoqlsN1IbTcN.
+    account_enabled: true
+    is_service_account: true
+    memberOf:
+      - on_premises_sam_account_name: sysusers
+  - id: bc596f3c-c955-4328-80a0-60d018b4ad57
+    preferred_name: reva
+    on_premises_sam_account_name: reva
+    mail: storage@example.org
+    display_name: Reva Inter Operability Platform
+    uid_number: 10001
+    gid_number: 15000
+    password_profile:
+      password: $6$rounds=91087$sa1u5Pmfo4cr23Vw$wPC3BbMTbP/ytlo0p.f99zJifyO70AUCdKIK9hkhwutBKGCirLmZs/MsWAG6xHjVvmnmHN5NoON7FUGv5pPaN.
+    account_enabled: true
+    is_service_account: true
+    memberOf:
+      - on_premises_sam_account_name: sysusers
+
+groups:
+  - id: 34f38767-c937-4eb6-b847-1c175829a2a0
+    gid_number: 15000
+    on_premises_sam_account_name: sysusers
+    display_name: Technical users
+    description: A group for technical users. They should not show up in sharing dialogs.
+  - id: 509a9dcd-bb37-4f4f-a01a-19dca27d9cfa
+    gid_number: 30000
+    on_premises_sam_account_name: users
+    display_name: Users
+    description: A group every normal user belongs to.
+  - id: 6040aa17-9c64-4fef-9bd0-77234d71bad0
+    gid_number: 30001
+    on_premises_sam_account_name: sailing-lovers
+    display_name: Sailing lovers
+  - id: dd58e5ec-842e-498b-8800-61f2ec6f911f
+    gid_number: 30002
+    on_premises_sam_account_name: violin-haters
+    display_name: Violin haters
+  - id: 7b87fd49-286e-4a5f-bafd-c535d5dd997a
+    gid_number: 30003
+    on_premises_sam_account_name: radium-lovers
+    display_name: Radium lovers
+  - id: cedc21aa-4072-4614-8676-fa9165f598ff
+    gid_number: 30004
+    on_premises_sam_account_name: polonium-lovers
+    display_name: Polonium lovers
+  - id: a1726108-01f8-4c30-88df-2b1a9d1cba1a
+    gid_number: 30005
+    on_premises_sam_account_name: quantum-lovers
+    display_name: Quantum lovers
+  - id: 167cbee2-0518-455a-bfb2-031fe0621e5d
+    gid_number: 30006
+    on_premises_sam_account_name: philosophy-haters
+    display_name: Philosophy haters
+  - id: 262982c1-2362-4afa-bfdf-8cbfef64a06e
+    gid_number: 30007
+    on_premises_sam_account_name: physics-lovers
+    display_name: Physics lovers
+`
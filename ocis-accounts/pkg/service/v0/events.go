@@ -0,0 +1,85 @@
+package service
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/micro/go-micro/v2/broker"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// eventTypes maps the target types and actions of the audit records to the types of the published events
+var eventTypes = map[string]string{
+	"account.create":            proto.EventAccountCreated,
+	"account.update":            proto.EventAccountUpdated,
+	"account.delete":            proto.EventAccountDeleted,
+	"account.restore":           proto.EventAccountRestored,
+	"account.purge":             proto.EventAccountPurged,
+	"account.revoke_sessions":   proto.EventAccountSessionsRevoked,
+	"account.rotate_api_key":    proto.EventAccountAPIKeyRevoked,
+	"account.revoke_api_key":    proto.EventAccountAPIKeyRevoked,
+	"account.link_identity":     proto.EventAccountUpdated,
+	"account.invite":            proto.EventAccountInvited,
+	"account.redeem_invitation": proto.EventAccountInvitationRedeemed,
+	"account.expire":            proto.EventAccountDisabled,
+	"account.scheduled_enable":  proto.EventAccountEnabled,
+	"account.unlink_identity":   proto.EventAccountUpdated,
+	"account.sync_create":       proto.EventAccountCreated,
+	"account.sync_update":       proto.EventAccountUpdated,
+	"account.sync_disable":      proto.EventAccountDisabled,
+	"group.create":              proto.EventGroupCreated,
+	"group.update":              proto.EventGroupUpdated,
+	"group.delete":              proto.EventGroupDeleted,
+	"group.restore":             proto.EventGroupRestored,
+	"group.purge":               proto.EventGroupPurged,
+	"group.add_member":          proto.EventGroupMemberAdded,
+	"group.remove_member":       proto.EventGroupMemberRemoved,
+	"group.add_owner":           proto.EventGroupOwnerAdded,
+	"group.remove_owner":        proto.EventGroupOwnerRemoved,
+	"group.sync_create":         proto.EventGroupCreated,
+	"group.sync_update":         proto.EventGroupUpdated,
+}
+
+// eventFromRecord returns the event for an audit record
+func eventFromRecord(r *proto.AuditRecord, eventType string) *proto.Event {
+	e := &proto.Event{
+		Type:      eventType,
+		Timestamp: r.Timestamp,
+		Actor:     r.Actor,
+		Paths:     r.Paths,
+	}
+	if r.TargetType == "group" {
+		e.GroupId = r.TargetId
+		e.AccountId = r.MemberId
+	} else {
+		e.AccountId = r.TargetId
+	}
+	return e
+}
+
+// publish publishes the event on the topic of its type. The message is encoded like the go-micro client encodes
+// published messages, so it can be consumed with a go-micro subscriber. Failing to publish is logged, the mutation has
+// already been persisted.
+func (s Service) publish(e *proto.Event) {
+	if s.broker == nil {
+		return
+	}
+
+	e.Id = uuid.Must(uuid.NewV4()).String()
+	body, err := gproto.Marshal(e)
+	if err != nil {
+		s.log.Error().Err(err).Str("type", e.Type).Msg("could not marshal event")
+		return
+	}
+
+	err = s.broker.Publish(e.Type, &broker.Message{
+		Header: map[string]string{
+			"Content-Type": "application/protobuf",
+			"Micro-Id":     e.Id,
+			"Micro-Topic":  e.Type,
+		},
+		Body: body,
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("type", e.Type).Str("account", e.AccountId).Str("group", e.GroupId).Msg("could not publish event")
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/micro/go-micro/v2/broker"
+	"github.com/micro/go-micro/v2/broker/memory"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+const eventsDataPath = "/var/tmp/ocis-accounts-events-tests"
+
+func TestEvents(t *testing.T) {
+	defer os.RemoveAll(eventsDataPath)
+
+	b := memory.NewBroker()
+	require.NoError(t, b.Connect())
+	defer b.Disconnect()
+
+	events := make(chan *proto.Event, 16)
+	for _, topic := range []string{proto.EventAccountCreated, proto.EventAccountUpdated, proto.EventAccountDisabled, proto.EventAccountDeleted, proto.EventGroupMemberAdded, proto.EventGroupMemberRemoved} {
+		_, err := b.Subscribe(topic, func(p broker.Event) error {
+			assert.Equal(t, "application/protobuf", p.Message().Header["Content-Type"])
+			e := &proto.Event{}
+			if err := gproto.Unmarshal(p.Message().Body, e); err != nil {
+				return err
+			}
+			assert.Equal(t, p.Topic(), e.Type)
+			events <- e
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	next := func() *proto.Event {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(time.Second):
+			t.Fatal("no event published")
+			return nil
+		}
+	}
+
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = eventsDataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	roleService := buildRoleServiceMock()
+	svc, err := New(Logger(olog.NewLogger()), Config(cfg), RoleService(roleService), RoleManager(buildRoleManager(roleService)), Broker(b))
+	require.NoError(t, err)
+
+	ctx := delegatedCtx("058bff95-6708-4fe5-91e4-9ea3d377588b", ssvc.BundleUUIDRoleAdmin)
+
+	a := &proto.Account{}
+	require.NoError(t, svc.CreateAccount(ctx, &proto.CreateAccountRequest{Account: &proto.Account{
+		AccountEnabled:           true,
+		PreferredName:            "published",
+		OnPremisesSamAccountName: "published",
+		Mail:                     "published@example.org",
+	}}, a))
+	e := next()
+	assert.Equal(t, proto.EventAccountCreated, e.Type)
+	assert.Equal(t, a.Id, e.AccountId)
+	assert.Equal(t, "058bff95-6708-4fe5-91e4-9ea3d377588b", e.Actor)
+	assert.NotEmpty(t, e.Id)
+
+	require.NoError(t, svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: a.Id, AccountEnabled: false},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+	}, &proto.Account{}))
+	e = next()
+	assert.Equal(t, proto.EventAccountUpdated, e.Type)
+	assert.Equal(t, []string{"AccountEnabled"}, e.Paths)
+	assert.Equal(t, proto.EventAccountDisabled, next().Type)
+
+	require.NoError(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa", AccountId: a.Id}, &proto.Group{}))
+	e = next()
+	assert.Equal(t, proto.EventGroupMemberAdded, e.Type)
+	assert.Equal(t, "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa", e.GroupId)
+	assert.Equal(t, a.Id, e.AccountId)
+
+	// deleting an account removes its memberships first
+	require.NoError(t, svc.DeleteAccount(ctx, &proto.DeleteAccountRequest{Id: a.Id}, &empty.Empty{}))
+	assert.Equal(t, proto.EventGroupMemberRemoved, next().Type)
+	e = next()
+	assert.Equal(t, proto.EventAccountDeleted, e.Type)
+	assert.Equal(t, a.Id, e.AccountId)
+}
@@ -6,18 +6,24 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/CiscoM31/godata"
 	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
+	fieldmask_utils "github.com/mennanov/fieldmask-utils"
 	merrors "github.com/micro/go-micro/v2/errors"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/provider"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s Service) indexGroups(path string) (err error) {
+func (s Service) indexGroups(ctx context.Context, path string) (err error) {
 	var f *os.File
 	if f, err = os.Open(path); err != nil {
 		s.log.Error().Err(err).Str("dir", path).Msg("could not open groups folder")
@@ -30,7 +36,7 @@ func (s Service) indexGroups(path string) (err error) {
 		return
 	}
 	for _, file := range list {
-		err = s.indexGroup(file.Name())
+		err = s.indexGroup(ctx, file.Name())
 		if err != nil {
 			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not index account")
 		}
@@ -42,11 +48,13 @@ func (s Service) indexGroups(path string) (err error) {
 // accLock mutually exclude readers from writers on group files
 var groupLock sync.Mutex
 
-func (s Service) indexGroup(id string) error {
+func (s Service) indexGroup(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "index.index", trace.StringAttribute("group_id", id))
+	defer func() { endSpan(span, err) }()
 	g := &proto.BleveGroup{
 		BleveType: "group",
 	}
-	if err := s.loadGroup(id, &g.Group); err != nil {
+	if err := s.loadGroup(ctx, id, &g.Group); err != nil {
 		s.log.Error().Err(err).Str("group", id).Msg("could not load group")
 		return err
 	}
@@ -58,7 +66,10 @@ func (s Service) indexGroup(id string) error {
 	return nil
 }
 
-func (s Service) loadGroup(id string, g *proto.Group) (err error) {
+func (s Service) loadGroup(ctx context.Context, id string, g *proto.Group) (err error) {
+	_, span := startSpan(ctx, "storage.read", trace.StringAttribute("type", "group"), trace.StringAttribute("group_id", id))
+	defer func() { endSpan(span, err) }()
+	defer s.metrics.ObserveStorage("read", "group", time.Now())
 	path := filepath.Join(s.Config.Server.AccountsDataPath, "groups", id)
 
 	groupLock.Lock()
@@ -75,7 +86,10 @@ func (s Service) loadGroup(id string, g *proto.Group) (err error) {
 	return
 }
 
-func (s Service) writeGroup(g *proto.Group) (err error) {
+func (s Service) writeGroup(ctx context.Context, g *proto.Group) (err error) {
+	_, span := startSpan(ctx, "storage.write", trace.StringAttribute("type", "group"), trace.StringAttribute("group_id", g.Id))
+	defer func() { endSpan(span, err) }()
+	defer s.metrics.ObserveStorage("write", "group", time.Now())
 
 	// leave only the member id
 	s.deflateMembers(g)
@@ -95,43 +109,80 @@ func (s Service) writeGroup(g *proto.Group) (err error) {
 	return
 }
 
-func (s Service) expandMembers(g *proto.Group) {
+func (s Service) expandMembers(ctx context.Context, g *proto.Group) {
 	if g == nil {
 		return
 	}
+	ctx, span := startSpan(ctx, "expandMembers", trace.StringAttribute("group_id", g.Id), trace.Int64Attribute("members", int64(len(g.Members))))
+	defer span.End()
+	g.Members = s.expandAccounts(ctx, g.Members)
+	// owners are stored like the members
+	g.Owners = s.expandAccounts(ctx, g.Owners)
+}
+
+// expandAccounts loads the referenced accounts
+func (s Service) expandAccounts(ctx context.Context, refs []*proto.Account) []*proto.Account {
 	expanded := []*proto.Account{}
-	for i := range g.Members {
+	for i := range refs {
 		// TODO resolve by name, when a create or update is issued they may not have an id? fall back to searching the group id in the index?
 		a := &proto.Account{}
-		if err := s.loadAccount(g.Members[i].Id, a); err == nil {
+		if err := s.loadAccount(ctx, refs[i].Id, a); err == nil {
+			hideSecrets(a)
 			expanded = append(expanded, a)
 		} else {
 			// log errors but continue execution for now
-			s.log.Error().Err(err).Str("id", g.Members[i].Id).Msg("could not load account")
+			s.log.Error().Err(err).Str("id", refs[i].Id).Msg("could not load account")
 		}
 	}
-	g.Members = expanded
+	return expanded
 }
 
-// deflateMembers replaces the users of a group with an instance that only contains the id
+// deflateMembers replaces the users and owners of a group with an instance that only contains the id
 func (s Service) deflateMembers(g *proto.Group) {
 	if g == nil {
 		return
 	}
+	g.Members = s.deflateAccounts(g.Id, g.Members)
+	g.Owners = s.deflateAccounts(g.Id, g.Owners)
+}
+
+// deflateAccounts replaces the accounts with an instance that only contains the id
+func (s Service) deflateAccounts(groupID string, refs []*proto.Account) []*proto.Account {
 	deflated := []*proto.Account{}
-	for i := range g.Members {
-		if g.Members[i].Id != "" {
-			deflated = append(deflated, &proto.Account{Id: g.Members[i].Id})
+	for i := range refs {
+		if refs[i].Id != "" {
+			deflated = append(deflated, &proto.Account{Id: refs[i].Id})
 		} else {
 			// TODO fetch and use an id when group only has a name but no id
-			s.log.Error().Str("id", g.Id).Interface("account", g.Members[i]).Msg("resolving members by name is not implemented yet")
+			s.log.Error().Str("id", groupID).Interface("account", refs[i]).Msg("resolving members by name is not implemented yet")
 		}
 	}
-	g.Members = deflated
+	return deflated
 }
 
 // ListGroups implements the GroupsServiceHandler interface
-func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out *proto.ListGroupsResponse) (err error) {
+func (s Service) ListGroups(ctx context.Context, in *proto.ListGroupsRequest, out *proto.ListGroupsResponse) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.ListGroups", trace.StringAttribute("query", in.Query))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "ListGroups", (*access).mayReadGroups); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		if err = s.listLDAPGroups(in, out); err != nil {
+			return
+		}
+		readable := make([]*proto.Group, 0, len(out.Groups))
+		for _, g := range out.Groups {
+			if acl.canReadGroup(g) {
+				readable = append(readable, g)
+			}
+		}
+		out.Groups = readable
+		return
+	}
 
 	// only search for groups
 	tq := bleve.NewTermQuery("group")
@@ -156,11 +207,16 @@ func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out
 		query.AddQuery(bq)
 	}
 
-	s.log.Debug().Interface("query", query).Msg("using query")
+	var sq bquery.Query = query
+	if !in.ShowDeleted {
+		sq = excludeDeleted(query)
+	}
+
+	s.log.Debug().Interface("query", sq).Msg("using query")
 
-	searchRequest := bleve.NewSearchRequest(query)
+	searchRequest := bleve.NewSearchRequest(sq)
 	var searchResult *bleve.SearchResult
-	searchResult, err = s.index.Search(searchRequest)
+	searchResult, err = s.search(ctx, searchRequest)
 	if err != nil {
 		s.log.Error().Err(err).Msg("could not execute bleve search")
 		return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
@@ -173,15 +229,18 @@ func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out
 	for _, hit := range searchResult.Hits {
 
 		g := &proto.Group{}
-		if err = s.loadGroup(hit.ID, g); err != nil {
+		if err = s.loadGroup(ctx, hit.ID, g); err != nil {
 			s.log.Error().Err(err).Str("group", hit.ID).Msg("could not load group, skipping")
 			continue
 		}
+		if !acl.canReadGroup(g) {
+			continue
+		}
 		s.log.Debug().Interface("group", g).Msg("found group")
 
 		// TODO add accounts if requested
 		// if in.FieldMask ...
-		s.expandMembers(g)
+		s.expandMembers(ctx, g)
 
 		out.Groups = append(out.Groups, g)
 	}
@@ -190,27 +249,57 @@ func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out
 }
 
 // GetGroup implements the GroupsServiceHandler interface
-func (s Service) GetGroup(c context.Context, in *proto.GetGroupRequest, out *proto.Group) (err error) {
+func (s Service) GetGroup(ctx context.Context, in *proto.GetGroupRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.GetGroup", trace.StringAttribute("group_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "GetGroup", (*access).mayReadOwnGroups); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		if err = s.getLDAPGroup(in, out); err == nil && !acl.canReadGroup(out) {
+			return s.forbidden("GetGroup")
+		}
+		return
+	}
+
 	var id string
 	if id, err = cleanupID(in.Id); err != nil {
 		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
 	}
 
-	if err = s.loadGroup(id, out); err != nil {
+	if err = s.loadGroup(ctx, id, out); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not load group")
 		return
 	}
+	if !acl.canReadGroup(out) {
+		return s.forbidden("GetGroup")
+	}
 	s.log.Debug().Interface("group", out).Msg("found group")
 
 	// TODO only add accounts if requested
 	// if in.FieldMask ...
-	s.expandMembers(out)
+	s.expandMembers(ctx, out)
 
 	return
 }
 
 // CreateGroup implements the GroupsServiceHandler interface
-func (s Service) CreateGroup(c context.Context, in *proto.CreateGroupRequest, out *proto.Group) (err error) {
+func (s Service) CreateGroup(ctx context.Context, in *proto.CreateGroupRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.CreateGroup", trace.StringAttribute("group_id", in.GetGroup().GetId()))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "CreateGroup", func(a *access) bool { return a.writeGroups }); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
 	var id string
 	if in.Group == nil {
 		return merrors.BadRequest(s.id, "account missing")
@@ -223,43 +312,200 @@ func (s Service) CreateGroup(c context.Context, in *proto.CreateGroupRequest, ou
 		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
 	}
 
-	// extract member id
+	posixLock.Lock()
+	defer posixLock.Unlock()
+	if err = s.assignGIDNumber(ctx, in.Group); err != nil {
+		return
+	}
+	if err = s.checkUniqueGroup(ctx, in.Group); err != nil {
+		return
+	}
+
+	// members and owners are checked like for AddMember and AddOwner before anything is written, so a failed request
+	// does not leave a half linked group behind
 	s.deflateMembers(in.Group)
+	var members, owners []*proto.Account
+	for _, ref := range in.Group.Members {
+		var a *proto.Account
+		if a, err = s.loadLinkableAccount(ctx, ref.Id); err != nil {
+			return
+		}
+		if !acl.managesMembers(in.Group) || !(acl.writeGroups || acl.managesAccount(a)) {
+			return s.forbidden("CreateGroup")
+		}
+		if !containsAccount(members, a.Id) {
+			members = append(members, a)
+		}
+	}
+	for _, ref := range in.Group.Owners {
+		var a *proto.Account
+		if a, err = s.loadLinkableAccount(ctx, ref.Id); err != nil {
+			return
+		}
+		if !containsAccount(owners, a.Id) {
+			owners = append(owners, a)
+		}
+	}
+
+	// the lifecycle metadata is maintained by the service, values sent by clients are ignored
+	in.Group.CreatedDateTime = timestamppb.Now()
+	in.Group.DeletedDateTime = nil
+	in.Group.Members, in.Group.Owners = members, owners
 
-	if err = s.writeGroup(in.Group); err != nil {
+	if err = s.writeGroup(ctx, in.Group); err != nil {
 		s.log.Error().Err(err).Interface("group", in.Group).Msg("could not persist new group")
 		return
 	}
+	if err = s.linkNewGroup(ctx, id, members); err != nil {
+		return
+	}
 
-	if err = s.indexGroup(id); err != nil {
+	if err = s.indexGroup(ctx, id); err != nil {
 		return merrors.InternalServerError(s.id, "could not index new group: %v", err.Error())
 	}
+	s.audit(ctx, "create", "group", id, "", nil)
+	for i := range members {
+		s.audit(ctx, "add_member", "group", id, members[i].Id, nil)
+	}
+	for i := range owners {
+		s.audit(ctx, "add_owner", "group", id, owners[i].Id, nil)
+	}
+	s.countDocuments(ctx, "group")
+
+	if err = s.loadGroup(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load new group")
+		return
+	}
+
+	s.expandMembers(ctx, out)
 
 	return
 }
 
 // UpdateGroup implements the GroupsServiceHandler interface
-func (s Service) UpdateGroup(c context.Context, in *proto.UpdateGroupRequest, out *proto.Group) (err error) {
-	return merrors.InternalServerError(s.id, "not implemented")
+// members and owners are not part of the updatable paths, they are managed with AddMember, RemoveMember, AddOwner and
+// RemoveOwner. Owners may only change the description of their groups.
+func (s Service) UpdateGroup(ctx context.Context, in *proto.UpdateGroupRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.UpdateGroup", trace.StringAttribute("group_id", in.GetGroup().GetId()))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "UpdateGroup", func(a *access) bool { return a.writeGroups || a.hasAccount() }); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	var id string
+	if in.Group == nil {
+		return merrors.BadRequest(s.id, "group missing")
+	}
+	if in.Group.Id == "" {
+		return merrors.BadRequest(s.id, "group id missing")
+	}
+
+	if id, err = cleanupID(in.Group.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	if err = s.loadGroup(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load group")
+		return
+	}
+
+	updatable := updatableGroupPaths
+	if !acl.writeGroups {
+		if !acl.isOwner(out) {
+			return s.forbidden("UpdateGroup")
+		}
+		updatable = ownerGroupPaths
+	}
+
+	if out.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "group %s is deleted", id)
+	}
+
+	var validMask fieldmask_utils.FieldFilterContainer
+	if validMask, err = validateUpdate(in.UpdateMask, updatable); err != nil {
+		return merrors.BadRequest(s.id, "%s", err)
+	}
+
+	prevName := out.OnPremisesSamAccountName
+	if err = fieldmask_utils.StructToStruct(validMask, in.Group, out); err != nil {
+		return merrors.InternalServerError(s.id, "%s", err)
+	}
+
+	if !strings.EqualFold(out.OnPremisesSamAccountName, prevName) {
+		if err = s.checkUniqueGroup(ctx, out); err != nil {
+			return
+		}
+	}
+
+	if err = s.writeGroup(ctx, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist updated group")
+		return
+	}
+
+	if err = s.indexGroup(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index updated group: %v", err.Error())
+	}
+	s.audit(ctx, "update", "group", id, "", updatedPaths(in.GetUpdateMask().GetPaths(), updatable))
+
+	s.expandMembers(ctx, out)
+
+	return
+}
+
+// whitelist of all paths/fields which can be updated by clients
+var updatableGroupPaths = map[string]struct{}{
+	"DisplayName":              {},
+	"Description":              {},
+	"OnPremisesImmutableId":    {},
+	"OnPremisesSamAccountName": {},
+	"HideFromAddressLists":     {},
+	"Visibility":               {},
+}
+
+// whitelist of the paths/fields owners can update in their groups
+var ownerGroupPaths = map[string]struct{}{
+	"Description": {},
 }
 
 // DeleteGroup implements the GroupsServiceHandler interface
-func (s Service) DeleteGroup(c context.Context, in *proto.DeleteGroupRequest, out *empty.Empty) (err error) {
+// the group is only marked as deleted, it is purged after the configured retention period
+func (s Service) DeleteGroup(ctx context.Context, in *proto.DeleteGroupRequest, out *empty.Empty) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.DeleteGroup", trace.StringAttribute("group_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, GroupManagementPermissionID, "DeleteGroup"); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
 	var id string
 	if id, err = cleanupID(in.Id); err != nil {
 		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
 	}
-	path := filepath.Join(s.Config.Server.AccountsDataPath, "groups", id)
 
 	g := &proto.Group{}
-	if err = s.loadGroup(id, g); err != nil {
+	if err = s.loadGroup(ctx, id, g); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
 		return
 	}
 
+	if g.DeletedDateTime != nil {
+		s.log.Debug().Str("id", id).Msg("group already deleted")
+		return
+	}
+
 	// delete memberof relationship in users
 	for i := range g.Members {
-		err = s.RemoveMember(c, &proto.RemoveMemberRequest{
+		err = s.RemoveMember(s.asService(ctx), &proto.RemoveMemberRequest{
 			AccountId: g.Members[i].Id,
 			GroupId:   id,
 		}, g)
@@ -267,22 +513,172 @@ func (s Service) DeleteGroup(c context.Context, in *proto.DeleteGroupRequest, ou
 			s.log.Error().Err(err).Str("groupid", id).Str("accountid", g.Members[i].Id).Msg("could not remove account memberof, skipping")
 		}
 	}
-	if err = os.Remove(path); err != nil {
-		s.log.Error().Err(err).Str("id", id).Str("path", path).Msg("could not remove group")
-		return merrors.InternalServerError(s.id, "could not remove group: %v", err.Error())
+
+	// the accounts are kept in members so they can be restored
+	g.DeletedDateTime = timestamppb.Now()
+
+	if err = s.writeGroup(ctx, g); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist deleted group")
+		return
 	}
 
-	if err = s.index.Delete(id); err != nil {
-		s.log.Error().Err(err).Str("id", id).Str("path", path).Msg("could not remove group from index")
-		return merrors.InternalServerError(s.id, "could not remove group from index: %v", err.Error())
+	if err = s.indexGroup(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index deleted group: %v", err.Error())
 	}
+	s.audit(ctx, "delete", "group", id, "", nil)
 
 	s.log.Info().Str("id", id).Msg("deleted group")
 	return
 }
 
-// AddMember implements the GroupsServiceHandler interface
-func (s Service) AddMember(c context.Context, in *proto.AddMemberRequest, out *proto.Group) (err error) {
+// RestoreGroup implements the GroupsServiceHandler interface
+// the accounts that were members of the group are added back
+func (s Service) RestoreGroup(ctx context.Context, in *proto.RestoreGroupRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.RestoreGroup", trace.StringAttribute("group_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, GroupManagementPermissionID, "RestoreGroup"); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	var id string
+	if id, err = cleanupID(in.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	g := &proto.Group{}
+	if err = s.loadGroup(ctx, id, g); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load group")
+		return
+	}
+
+	if g.DeletedDateTime == nil {
+		return merrors.BadRequest(s.id, "group %s is not deleted", id)
+	}
+
+	members := g.Members
+	g.Members = nil
+	g.DeletedDateTime = nil
+
+	if err = s.writeGroup(ctx, g); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist restored group")
+		return
+	}
+
+	// restore memberof relationship in users
+	for i := range members {
+		err = s.AddMember(s.asService(ctx), &proto.AddMemberRequest{
+			AccountId: members[i].Id,
+			GroupId:   id,
+		}, &proto.Group{})
+		if err != nil {
+			s.log.Error().Err(err).Str("groupid", id).Str("accountid", members[i].Id).Msg("could not restore account memberof, skipping")
+		}
+	}
+
+	if err = s.indexGroup(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index restored group: %v", err.Error())
+	}
+	s.audit(ctx, "restore", "group", id, "", nil)
+
+	if err = s.loadGroup(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load restored group")
+		return
+	}
+
+	s.expandMembers(ctx, out)
+
+	s.log.Info().Str("id", id).Msg("restored group")
+	return
+}
+
+// loadLinkableAccount loads an account that is added to a group as member or owner, it returns an error if the
+// account can not be added
+func (s Service) loadLinkableAccount(ctx context.Context, id string) (*proto.Account, error) {
+	accountID, err := cleanupID(id)
+	if err != nil {
+		return nil, merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+	a := &proto.Account{}
+	if err := s.loadAccount(ctx, accountID, a); err != nil {
+		return nil, err
+	}
+	if a.DeletedDateTime != nil {
+		return nil, merrors.BadRequest(s.id, "account %s is deleted", accountID)
+	}
+	return a, nil
+}
+
+// containsAccount checks if the account is in the list
+func containsAccount(accounts []*proto.Account, id string) bool {
+	for i := range accounts {
+		if accounts[i].Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// linkNewGroup adds a new group to the memberships of its members. If an account can not be written the accounts
+// written so far and the group are removed again, so the group does not exist with members that are not linked.
+func (s Service) linkNewGroup(ctx context.Context, groupID string, members []*proto.Account) (err error) {
+	for i := range members {
+		members[i].MemberOf = append(members[i].MemberOf, &proto.Group{Id: groupID})
+		if err = s.writeAccount(ctx, members[i]); err != nil {
+			s.log.Error().Err(err).Str("id", groupID).Str("account", members[i].Id).Msg("could not add member to new group")
+			s.unlinkNewGroup(ctx, groupID, members[:i])
+			return
+		}
+		if err = s.indexAccount(ctx, members[i].Id); err != nil {
+			s.log.Error().Err(err).Str("account", members[i].Id).Msg("could not index account, skipping")
+		}
+	}
+	return nil
+}
+
+// unlinkNewGroup reverts linkNewGroup for the accounts it has written and removes the group
+func (s Service) unlinkNewGroup(ctx context.Context, groupID string, linked []*proto.Account) {
+	for i := range linked {
+		memberOf := make([]*proto.Group, 0, len(linked[i].MemberOf))
+		for _, g := range linked[i].MemberOf {
+			if g.Id != groupID {
+				memberOf = append(memberOf, g)
+			}
+		}
+		linked[i].MemberOf = memberOf
+		if err := s.writeAccount(ctx, linked[i]); err != nil {
+			s.log.Error().Err(err).Str("id", groupID).Str("account", linked[i].Id).Msg("could not remove member of failed group")
+			continue
+		}
+		if err := s.indexAccount(ctx, linked[i].Id); err != nil {
+			s.log.Error().Err(err).Str("account", linked[i].Id).Msg("could not index account, skipping")
+		}
+	}
+	groupLock.Lock()
+	defer groupLock.Unlock()
+	if err := os.Remove(filepath.Join(s.Config.Server.AccountsDataPath, "groups", groupID)); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not remove failed group")
+	}
+}
+
+// AddMember implements the GroupsServiceHandler interface. Adding an account to a group can bring it into the scope of
+// a scoped administrator, so owners and scoped administrators may only add the accounts they manage.
+func (s Service) AddMember(ctx context.Context, in *proto.AddMemberRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.AddMember", trace.StringAttribute("group_id", in.GroupId), trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "AddMember", (*access).mayManageMembers); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
 
 	// cleanup ids
 	var groupID string
@@ -297,16 +693,26 @@ func (s Service) AddMember(c context.Context, in *proto.AddMemberRequest, out *p
 
 	// load structs
 	a := &proto.Account{}
-	if err = s.loadAccount(accountID, a); err != nil {
+	if err = s.loadAccount(ctx, accountID, a); err != nil {
 		s.log.Error().Err(err).Str("id", accountID).Msg("could not load account")
 		return
 	}
 
 	g := &proto.Group{}
-	if err = s.loadGroup(groupID, g); err != nil {
+	if err = s.loadGroup(ctx, groupID, g); err != nil {
 		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
 		return
 	}
+	if !acl.managesMembers(g) || !(acl.writeGroups || acl.managesAccount(a)) {
+		return s.forbidden("AddMember")
+	}
+
+	if a.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "account %s is deleted", accountID)
+	}
+	if g.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "group %s is deleted", groupID)
+	}
 
 	// check if we need to add the account to the group
 	alreadyRelated := false
@@ -331,14 +737,15 @@ func (s Service) AddMember(c context.Context, in *proto.AddMemberRequest, out *p
 		a.MemberOf = append(a.MemberOf, g)
 	}
 
-	if err = s.writeAccount(a); err != nil {
+	if err = s.writeAccount(ctx, a); err != nil {
 		s.log.Error().Err(err).Interface("account", a).Msg("could not persist account")
 		return
 	}
-	if err = s.writeGroup(g); err != nil {
+	if err = s.writeGroup(ctx, g); err != nil {
 		s.log.Error().Err(err).Interface("group", g).Msg("could not persist group")
 		return
 	}
+	s.audit(ctx, "add_member", "group", groupID, accountID, nil)
 	// FIXME update index!
 	// TODO rollback changes when only one of them failed?
 	// TODO store relation in another file?
@@ -347,7 +754,18 @@ func (s Service) AddMember(c context.Context, in *proto.AddMemberRequest, out *p
 }
 
 // RemoveMember implements the GroupsServiceHandler interface
-func (s Service) RemoveMember(c context.Context, in *proto.RemoveMemberRequest, out *proto.Group) (err error) {
+func (s Service) RemoveMember(ctx context.Context, in *proto.RemoveMemberRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.RemoveMember", trace.StringAttribute("group_id", in.GroupId), trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "RemoveMember", (*access).mayManageMembers); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
 
 	// cleanup ids
 	var groupID string
@@ -362,16 +780,19 @@ func (s Service) RemoveMember(c context.Context, in *proto.RemoveMemberRequest,
 
 	// load structs
 	a := &proto.Account{}
-	if err = s.loadAccount(accountID, a); err != nil {
+	if err = s.loadAccount(ctx, accountID, a); err != nil {
 		s.log.Error().Err(err).Str("id", accountID).Msg("could not load account")
 		return
 	}
 
 	g := &proto.Group{}
-	if err = s.loadGroup(groupID, g); err != nil {
+	if err = s.loadGroup(ctx, groupID, g); err != nil {
 		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
 		return
 	}
+	if !acl.managesMembers(g) {
+		return s.forbidden("RemoveMember")
+	}
 
 	//remove the account from the group if it exists
 	newMembers := []*proto.Account{}
@@ -391,14 +812,15 @@ func (s Service) RemoveMember(c context.Context, in *proto.RemoveMemberRequest,
 	}
 	a.MemberOf = newGroups
 
-	if err = s.writeAccount(a); err != nil {
+	if err = s.writeAccount(ctx, a); err != nil {
 		s.log.Error().Err(err).Interface("account", a).Msg("could not persist account")
 		return
 	}
-	if err = s.writeGroup(g); err != nil {
+	if err = s.writeGroup(ctx, g); err != nil {
 		s.log.Error().Err(err).Interface("group", g).Msg("could not persist group")
 		return
 	}
+	s.audit(ctx, "remove_member", "group", groupID, accountID, nil)
 	// FIXME update index!
 	// TODO rollback changes when only one of them failed?
 	// TODO store relation in another file?
@@ -407,7 +829,22 @@ func (s Service) RemoveMember(c context.Context, in *proto.RemoveMemberRequest,
 }
 
 // ListMembers implements the GroupsServiceHandler interface
-func (s Service) ListMembers(c context.Context, in *proto.ListMembersRequest, out *proto.ListMembersResponse) (err error) {
+func (s Service) ListMembers(ctx context.Context, in *proto.ListMembersRequest, out *proto.ListMembersResponse) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.ListMembers", trace.StringAttribute("group_id", in.Id), trace.StringAttribute("query", in.Query))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "ListMembers", (*access).mayReadOwnGroups); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		// the ldap backend does not return the owners of groups, only readers may list the members
+		if !acl.readGroups && !inScope(acl.accountScopes, in.Id) && !inScope(acl.memberScopes, in.Id) {
+			return s.forbidden("ListMembers")
+		}
+		return s.listLDAPMembers(in, out)
+	}
 
 	// cleanup ids
 	var groupID string
@@ -416,16 +853,335 @@ func (s Service) ListMembers(c context.Context, in *proto.ListMembersRequest, ou
 	}
 
 	g := &proto.Group{}
-	if err = s.loadGroup(groupID, g); err != nil {
+	if err = s.loadGroup(ctx, groupID, g); err != nil {
 		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
 		return
 	}
+	if !acl.canReadGroup(g) {
+		return s.forbidden("ListMembers")
+	}
 
 	// TODO only expand accounts if requested
 	// if in.FieldMask ...
-	s.expandMembers(g)
+	s.expandMembers(ctx, g)
 
 	out.Members = g.Members
 
 	return
 }
+
+// AddOwner implements the GroupsServiceHandler interface
+// owners are managed by group administrators, they may change the members and the description of the group
+func (s Service) AddOwner(ctx context.Context, in *proto.AddOwnerRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.AddOwner", trace.StringAttribute("group_id", in.GroupId), trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, GroupManagementPermissionID, "AddOwner"); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	// cleanup ids
+	var groupID string
+	if groupID, err = cleanupID(in.GroupId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	var accountID string
+	if accountID, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	// load structs
+	a := &proto.Account{}
+	if err = s.loadAccount(ctx, accountID, a); err != nil {
+		s.log.Error().Err(err).Str("id", accountID).Msg("could not load account")
+		return
+	}
+
+	if err = s.loadGroup(ctx, groupID, out); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
+		return
+	}
+
+	if a.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "account %s is deleted", accountID)
+	}
+	if out.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "group %s is deleted", groupID)
+	}
+
+	for i := range out.Owners {
+		if out.Owners[i].Id == a.Id {
+			s.expandMembers(ctx, out)
+			return nil
+		}
+	}
+	out.Owners = append(out.Owners, a)
+
+	if err = s.writeGroup(ctx, out); err != nil {
+		s.log.Error().Err(err).Interface("group", out).Msg("could not persist group")
+		return
+	}
+	// the owners are indexed for ListOwnedGroups
+	if err = s.indexGroup(ctx, groupID); err != nil {
+		return merrors.InternalServerError(s.id, "could not index updated group: %v", err.Error())
+	}
+	s.audit(ctx, "add_owner", "group", groupID, accountID, nil)
+
+	s.expandMembers(ctx, out)
+	return nil
+}
+
+// RemoveOwner implements the GroupsServiceHandler interface
+func (s Service) RemoveOwner(ctx context.Context, in *proto.RemoveOwnerRequest, out *proto.Group) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.RemoveOwner", trace.StringAttribute("group_id", in.GroupId), trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, GroupManagementPermissionID, "RemoveOwner"); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	// cleanup ids
+	var groupID string
+	if groupID, err = cleanupID(in.GroupId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	var accountID string
+	if accountID, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	if err = s.loadGroup(ctx, groupID, out); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
+		return
+	}
+
+	// the account may already have been purged, so it is not loaded
+	newOwners := []*proto.Account{}
+	for i := range out.Owners {
+		if out.Owners[i].Id != accountID {
+			newOwners = append(newOwners, out.Owners[i])
+		}
+	}
+	if len(newOwners) == len(out.Owners) {
+		return merrors.NotFound(s.id, "account %s does not own group %s", accountID, groupID)
+	}
+	out.Owners = newOwners
+
+	if err = s.writeGroup(ctx, out); err != nil {
+		s.log.Error().Err(err).Interface("group", out).Msg("could not persist group")
+		return
+	}
+	if err = s.indexGroup(ctx, groupID); err != nil {
+		return merrors.InternalServerError(s.id, "could not index updated group: %v", err.Error())
+	}
+	s.audit(ctx, "remove_owner", "group", groupID, accountID, nil)
+
+	s.expandMembers(ctx, out)
+	return nil
+}
+
+// ListOwners implements the GroupsServiceHandler interface
+func (s Service) ListOwners(ctx context.Context, in *proto.ListOwnersRequest, out *proto.ListOwnersResponse) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.ListOwners", trace.StringAttribute("group_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "ListOwners", (*access).mayReadOwnGroups); err != nil {
+		return
+	}
+
+	if s.ldap != nil {
+		// the ldap backend does not return the owners of groups
+		out.Owners = []*proto.Account{}
+		return
+	}
+
+	var groupID string
+	if groupID, err = cleanupID(in.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	g := &proto.Group{}
+	if err = s.loadGroup(ctx, groupID, g); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
+		return
+	}
+	if !acl.canReadGroup(g) {
+		return s.forbidden("ListOwners")
+	}
+
+	out.Owners = s.expandAccounts(ctx, g.Owners)
+	return
+}
+
+// ListOwnedGroups implements the GroupsServiceHandler interface
+// users list the groups they own, listing the groups of other accounts requires the permission to read groups
+func (s Service) ListOwnedGroups(ctx context.Context, in *proto.ListOwnedGroupsRequest, out *proto.ListGroupsResponse) (err error) {
+	ctx, span := startSpan(ctx, "GroupsService.ListOwnedGroups", trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "ListOwnedGroups", (*access).mayReadOwnGroups); err != nil {
+		return
+	}
+
+	if in.AccountId == "" {
+		in.AccountId = acl.accountID
+	}
+	if in.AccountId == "" {
+		return merrors.BadRequest(s.id, "account id missing")
+	}
+	if in.AccountId != acl.accountID && !acl.readGroups {
+		return s.forbidden("ListOwnedGroups")
+	}
+
+	out.Groups = make([]*proto.Group, 0)
+	if s.ldap != nil {
+		// the ldap backend does not return the owners of groups
+		return
+	}
+
+	var accountID string
+	if accountID, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	// only search for groups
+	tq := bleve.NewTermQuery("group")
+	tq.SetField("bleve_type")
+	oq := bleve.NewTermQuery(accountID)
+	oq.SetField("owners.id")
+
+	var sq bquery.Query = bleve.NewConjunctionQuery(tq, oq)
+	if !in.ShowDeleted {
+		sq = excludeDeleted(sq)
+	}
+
+	var searchResult *bleve.SearchResult
+	if searchResult, err = s.search(ctx, bleve.NewSearchRequest(sq)); err != nil {
+		s.log.Error().Err(err).Msg("could not execute bleve search")
+		return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+	}
+
+	for _, hit := range searchResult.Hits {
+		g := &proto.Group{}
+		if err = s.loadGroup(ctx, hit.ID, g); err != nil {
+			s.log.Error().Err(err).Str("group", hit.ID).Msg("could not load group, skipping")
+			continue
+		}
+		s.expandMembers(ctx, g)
+		out.Groups = append(out.Groups, g)
+	}
+	return nil
+}
+
+// resolveGroups looks up the groups referenced by id or by on_premises_sam_account_name and returns them with only their id set
+func (s Service) resolveGroups(ctx context.Context, refs []*proto.Group) ([]*proto.Group, error) {
+	resolved := []*proto.Group{}
+	seen := map[string]struct{}{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		id, err := s.resolveGroupID(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		resolved = append(resolved, &proto.Group{Id: id})
+	}
+	return resolved, nil
+}
+
+// resolveGroupID returns the id of an existing group referenced by id or by on_premises_sam_account_name
+func (s Service) resolveGroupID(ctx context.Context, ref *proto.Group) (string, error) {
+	g := &proto.Group{}
+	switch {
+	case ref.Id != "":
+		id, err := cleanupID(ref.Id)
+		if err != nil {
+			return "", merrors.BadRequest(s.id, "%s", err)
+		}
+		if err := s.loadGroup(ctx, id, g); err != nil {
+			return "", merrors.BadRequest(s.id, "group %s does not exist", ref.Id)
+		}
+	case ref.OnPremisesSamAccountName != "":
+		ids, err := s.findCandidates(ctx, "group", "on_premises_sam_account_name", ref.OnPremisesSamAccountName)
+		if err != nil {
+			return "", merrors.InternalServerError(s.id, "could not resolve group '%s': %v", ref.OnPremisesSamAccountName, err.Error())
+		}
+		for _, id := range ids {
+			candidate := &proto.Group{}
+			if err := s.loadGroup(ctx, id, candidate); err != nil {
+				s.log.Error().Err(err).Str("id", id).Msg("could not load group, skipping")
+				continue
+			}
+			if strings.EqualFold(candidate.OnPremisesSamAccountName, ref.OnPremisesSamAccountName) {
+				g = candidate
+				break
+			}
+		}
+		if g.Id == "" {
+			return "", merrors.BadRequest(s.id, "group '%s' does not exist", ref.OnPremisesSamAccountName)
+		}
+	default:
+		return "", merrors.BadRequest(s.id, "group reference needs an id or an on_premises_sam_account_name")
+	}
+	if g.DeletedDateTime != nil {
+		return "", merrors.BadRequest(s.id, "group %s is deleted", g.Id)
+	}
+	return g.Id, nil
+}
+
+// updateMemberOf makes the account a member of exactly the given groups, both sides of the relation are updated
+// groups have to be resolved with resolveGroups first
+func (s Service) updateMemberOf(ctx context.Context, a *proto.Account, groups []*proto.Group) (err error) {
+	wanted := map[string]struct{}{}
+	for i := range groups {
+		wanted[groups[i].Id] = struct{}{}
+	}
+	current := map[string]struct{}{}
+	for i := range a.MemberOf {
+		current[a.MemberOf[i].Id] = struct{}{}
+	}
+
+	changed := []string{}
+	for id := range current {
+		if _, ok := wanted[id]; ok {
+			continue
+		}
+		if err = s.RemoveMember(s.asService(ctx), &proto.RemoveMemberRequest{GroupId: id, AccountId: a.Id}, &proto.Group{}); err != nil {
+			return
+		}
+		changed = append(changed, id)
+	}
+	for i := range groups {
+		if _, ok := current[groups[i].Id]; ok {
+			continue
+		}
+		if err = s.AddMember(s.asService(ctx), &proto.AddMemberRequest{GroupId: groups[i].Id, AccountId: a.Id}, &proto.Group{}); err != nil {
+			return
+		}
+		changed = append(changed, groups[i].Id)
+	}
+
+	for _, id := range changed {
+		if err := s.indexGroup(ctx, id); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not index group")
+		}
+	}
+	return nil
+}
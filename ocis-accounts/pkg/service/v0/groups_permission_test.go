@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPermissionsGroups checks permission handling on all group methods
+func TestPermissionsGroups(t *testing.T) {
+	methods := map[string]func(ctx context.Context) error{
+		"ListGroups": func(ctx context.Context) error {
+			return s.ListGroups(ctx, &proto.ListGroupsRequest{}, &proto.ListGroupsResponse{})
+		},
+		"GetGroup": func(ctx context.Context) error {
+			return s.GetGroup(ctx, &proto.GetGroupRequest{}, &proto.Group{})
+		},
+		"CreateGroup": func(ctx context.Context) error {
+			return s.CreateGroup(ctx, &proto.CreateGroupRequest{}, &proto.Group{})
+		},
+		"UpdateGroup": func(ctx context.Context) error {
+			return s.UpdateGroup(ctx, &proto.UpdateGroupRequest{}, &proto.Group{})
+		},
+		"DeleteGroup": func(ctx context.Context) error {
+			return s.DeleteGroup(ctx, &proto.DeleteGroupRequest{}, &empty.Empty{})
+		},
+		"RestoreGroup": func(ctx context.Context) error {
+			return s.RestoreGroup(ctx, &proto.RestoreGroupRequest{}, &proto.Group{})
+		},
+		"AddMember": func(ctx context.Context) error {
+			return s.AddMember(ctx, &proto.AddMemberRequest{}, &proto.Group{})
+		},
+		"RemoveMember": func(ctx context.Context) error {
+			return s.RemoveMember(ctx, &proto.RemoveMemberRequest{}, &proto.Group{})
+		},
+		"ListMembers": func(ctx context.Context) error {
+			return s.ListMembers(ctx, &proto.ListMembersRequest{}, &proto.ListMembersResponse{})
+		},
+		"AddOwner": func(ctx context.Context) error {
+			return s.AddOwner(ctx, &proto.AddOwnerRequest{}, &proto.Group{})
+		},
+		"RemoveOwner": func(ctx context.Context) error {
+			return s.RemoveOwner(ctx, &proto.RemoveOwnerRequest{}, &proto.Group{})
+		},
+		"ListOwners": func(ctx context.Context) error {
+			return s.ListOwners(ctx, &proto.ListOwnersRequest{}, &proto.ListOwnersResponse{})
+		},
+		"ListOwnedGroups": func(ctx context.Context) error {
+			return s.ListOwnedGroups(ctx, &proto.ListOwnedGroupsRequest{}, &proto.ListGroupsResponse{})
+		},
+	}
+	// users may call these methods for the groups they own, the permission is checked against the group
+	ownerMethods := map[string]bool{
+		"GetGroup":        true,
+		"UpdateGroup":     true,
+		"AddMember":       true,
+		"RemoveMember":    true,
+		"ListMembers":     true,
+		"ListOwners":      true,
+		"ListOwnedGroups": true,
+	}
+
+	for method, call := range methods {
+		var userError error = merrors.Forbidden(s.id, "no permission for "+method)
+		if ownerMethods[method] {
+			userError = nil
+		}
+		var scenarios = []struct {
+			name            string
+			ctx             context.Context
+			permissionError error
+		}{
+			{
+				method + " fails when unauthenticated",
+				buildTestCtx(t, nil),
+				merrors.Unauthorized(s.id, "authentication required for "+method),
+			},
+			{
+				method + " is checked when no admin roleID in context",
+				buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser, ssvc.BundleUUIDRoleGuest}),
+				userError,
+			},
+			{
+				method + " succeeds when admin roleID in context",
+				buildTestCtx(t, []string{ssvc.BundleUUIDRoleAdmin}),
+				nil,
+			},
+			{
+				method + " succeeds when called by a service",
+				serviceCtx(),
+				nil,
+			},
+		}
+
+		for _, scenario := range scenarios {
+			t.Run(scenario.name, func(t *testing.T) {
+				teardown := setup()
+				defer teardown()
+
+				err := call(scenario.ctx)
+				if scenario.permissionError != nil {
+					assert.Equal(t, scenario.permissionError, err)
+				} else if err != nil {
+					// we are only checking permissions here, so just check that the error code is not 401 or 403
+					merr := merrors.FromError(err)
+					assert.NotEqual(t, http.StatusUnauthorized, merr.GetCode())
+					assert.NotEqual(t, http.StatusForbidden, merr.GetCode())
+				}
+			})
+		}
+	}
+}
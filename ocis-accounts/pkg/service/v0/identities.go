@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+
+	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultSignInType is the sign in type of linked identities that don't specify one
+const defaultSignInType = "federated"
+
+// identityKey joins the issuer and the issuer assigned id of an identity to the term it is indexed as
+func identityKey(issuer, issuerAssignedID string) string {
+	return issuer + "\x00" + issuerAssignedID
+}
+
+// indexOfIdentity returns the position of the identity in the list, -1 if it is not contained
+func indexOfIdentity(identities []*proto.Identities, issuer, issuerAssignedID string) int {
+	for i := range identities {
+		if identities[i].Issuer == issuer && identities[i].IssuerAssignedId == issuerAssignedID {
+			return i
+		}
+	}
+	return -1
+}
+
+// findLinkedAccounts returns the ids of the accounts an identity is linked to, deleted accounts are only returned
+// when requested
+func (s Service) findLinkedAccounts(ctx context.Context, issuer, issuerAssignedID string, showDeleted bool) ([]string, error) {
+	tq := bleve.NewTermQuery("account")
+	tq.SetField("bleve_type")
+	kq := bleve.NewTermQuery(identityKey(issuer, issuerAssignedID))
+	kq.SetField("identity_keys")
+
+	var q bquery.Query = bleve.NewConjunctionQuery(tq, kq)
+	if !showDeleted {
+		q = excludeDeleted(q)
+	}
+	searchResult, err := s.search(ctx, bleve.NewSearchRequest(q))
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not execute bleve search")
+		return nil, merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+	}
+	ids := make([]string, 0, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids, nil
+}
+
+// checkUniqueIdentities returns a conflict error if an identity that is not linked to the previous version of the
+// account is linked to another account. Identities without an issuer or an issuer assigned id are not checked.
+func (s Service) checkUniqueIdentities(ctx context.Context, a *proto.Account, prev *proto.Account) error {
+	seen := map[string]struct{}{}
+	for _, i := range a.Identities {
+		if i.Issuer == "" || i.IssuerAssignedId == "" {
+			continue
+		}
+		key := identityKey(i.Issuer, i.IssuerAssignedId)
+		if _, ok := seen[key]; ok {
+			return merrors.Conflict(s.id, "identity '%s' of issuer '%s' is listed twice", i.IssuerAssignedId, i.Issuer)
+		}
+		seen[key] = struct{}{}
+		if prev != nil && indexOfIdentity(prev.Identities, i.Issuer, i.IssuerAssignedId) >= 0 {
+			continue
+		}
+		ids, err := s.findLinkedAccounts(ctx, i.Issuer, i.IssuerAssignedId, true)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if id != a.Id {
+				return merrors.Conflict(s.id, "identity '%s' of issuer '%s' is already linked to account %s", i.IssuerAssignedId, i.Issuer, id)
+			}
+		}
+	}
+	return nil
+}
+
+// provisionAccount creates the account for an unknown identity from its claims
+func (s Service) provisionAccount(ctx context.Context, in *proto.ResolveIdentityRequest, out *proto.Account) error {
+	claims := make(map[string]string, len(in.Claims))
+	for _, c := range in.Claims {
+		claims[c.Name] = c.Value
+	}
+	cfg := s.Config.Provisioning
+	username := claims[cfg.UsernameClaim]
+	if username == "" {
+		return merrors.BadRequest(s.id, "claim '%s' missing, can not provision an account", cfg.UsernameClaim)
+	}
+	displayName := claims[cfg.DisplayNameClaim]
+	if displayName == "" {
+		displayName = username
+	}
+
+	acc := &proto.Account{
+		PreferredName:            username,
+		OnPremisesSamAccountName: username,
+		DisplayName:              displayName,
+		Mail:                     claims[cfg.MailClaim],
+		AccountEnabled:           true,
+		Identities: []*proto.Identities{{
+			SignInType:       defaultSignInType,
+			Issuer:           in.Issuer,
+			IssuerAssignedId: in.IssuerAssignedId,
+		}},
+	}
+	// the caller only has to be able to resolve identities, the creation is done by the service
+	if err := s.CreateAccount(s.asService(ctx), &proto.CreateAccountRequest{Account: acc}, out); err != nil {
+		s.log.Error().Err(err).Str("issuer", in.Issuer).Str("username", username).Msg("could not provision account")
+		return err
+	}
+	s.log.Info().Str("id", out.Id).Str("issuer", in.Issuer).Str("username", username).Msg("provisioned account")
+	return nil
+}
+
+// ResolveIdentity implements the AccountsServiceHandler interface
+func (s Service) ResolveIdentity(ctx context.Context, in *proto.ResolveIdentityRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.ResolveIdentity", trace.StringAttribute("issuer", in.Issuer))
+	defer func() { endSpan(span, err) }()
+
+	if _, err = s.authorize(ctx, "ResolveIdentity", (*access).mayReadAccounts); err != nil {
+		return
+	}
+	if in.Issuer == "" || in.IssuerAssignedId == "" {
+		return merrors.BadRequest(s.id, "issuer and issuer_assigned_id are required")
+	}
+	if s.ldap != nil {
+		return merrors.MethodNotAllowed(s.id, "identities are not supported by the ldap backend")
+	}
+
+	accLock.Lock()
+	var ids []string
+	if ids, err = s.findLinkedAccounts(ctx, in.Issuer, in.IssuerAssignedId, false); err == nil && len(ids) > 0 {
+		if err = s.loadAccount(ctx, ids[0], out); err == nil {
+			s.expandMemberOf(ctx, out)
+		}
+	}
+	accLock.Unlock()
+	if err != nil {
+		return
+	}
+
+	if len(ids) == 0 {
+		if !s.Config.Provisioning.Enabled {
+			return merrors.NotFound(s.id, "no account is linked to identity '%s' of issuer '%s'", in.IssuerAssignedId, in.Issuer)
+		}
+		// provisioning takes the lock itself
+		span.AddAttributes(trace.BoolAttribute("provisioned", true))
+		return s.provisionAccount(ctx, in, out)
+	}
+	hideSecrets(out)
+	return nil
+}
+
+// LinkIdentity implements the AccountsServiceHandler interface
+func (s Service) LinkIdentity(ctx context.Context, in *proto.LinkIdentityRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.LinkIdentity", trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "LinkIdentity", (*access).mayManageAccounts); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+	identity := in.Identity
+	if identity == nil || identity.Issuer == "" || identity.IssuerAssignedId == "" {
+		return merrors.BadRequest(s.id, "identity with issuer and issuer_assigned_id required")
+	}
+	if identity.SignInType == "" {
+		identity.SignInType = defaultSignInType
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	var id string
+	if id, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+	if err = s.loadAccount(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+	if !acl.managesAccount(out) {
+		return s.forbidden("LinkIdentity")
+	}
+	if out.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "account %s is deleted", id)
+	}
+	if indexOfIdentity(out.Identities, identity.Issuer, identity.IssuerAssignedId) >= 0 {
+		return merrors.Conflict(s.id, "identity '%s' of issuer '%s' is already linked to account %s", identity.IssuerAssignedId, identity.Issuer, id)
+	}
+
+	prev := &proto.Account{Id: out.Id, Identities: out.Identities}
+	out.Identities = append(out.Identities, identity)
+	if err = s.checkUniqueIdentities(ctx, out, prev); err != nil {
+		return
+	}
+	if err = s.persistIdentities(ctx, out); err != nil {
+		return
+	}
+	s.audit(ctx, "link_identity", "account", id, "", []string{"Identities"})
+
+	s.expandMemberOf(ctx, out)
+	hideSecrets(out)
+	return nil
+}
+
+// UnlinkIdentity implements the AccountsServiceHandler interface
+func (s Service) UnlinkIdentity(ctx context.Context, in *proto.UnlinkIdentityRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.UnlinkIdentity", trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "UnlinkIdentity", (*access).mayManageAccounts); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	var id string
+	if id, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+	if err = s.loadAccount(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+	if !acl.managesAccount(out) {
+		return s.forbidden("UnlinkIdentity")
+	}
+	i := indexOfIdentity(out.Identities, in.Issuer, in.IssuerAssignedId)
+	if i < 0 {
+		return merrors.NotFound(s.id, "identity '%s' of issuer '%s' is not linked to account %s", in.IssuerAssignedId, in.Issuer, id)
+	}
+
+	out.Identities = append(out.Identities[:i], out.Identities[i+1:]...)
+	if err = s.persistIdentities(ctx, out); err != nil {
+		return
+	}
+	s.audit(ctx, "unlink_identity", "account", id, "", []string{"Identities"})
+
+	s.expandMemberOf(ctx, out)
+	hideSecrets(out)
+	return nil
+}
+
+// persistIdentities writes and indexes the account after its identities have been changed
+func (s Service) persistIdentities(ctx context.Context, a *proto.Account) (err error) {
+	a.LastModifiedDateTime = timestamppb.Now()
+	if err = s.writeAccount(ctx, a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist identities")
+		return
+	}
+	if err = s.indexAccount(ctx, a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const testIssuer = "https://idp.example.org"
+
+func TestIdentities(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	resolve := func(subject string) (*proto.Account, error) {
+		a := &proto.Account{}
+		err := svc.ResolveIdentity(serviceCtx(), &proto.ResolveIdentityRequest{Issuer: testIssuer, IssuerAssignedId: subject}, a)
+		return a, err
+	}
+
+	_, err := resolve("einstein-sub")
+	assertCode(t, 404, err)
+
+	// only administrators link identities
+	userCtx := buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser})
+	link := &proto.LinkIdentityRequest{AccountId: einsteinID, Identity: &proto.Identities{Issuer: testIssuer, IssuerAssignedId: "einstein-sub"}}
+	assertForbidden(t, svc.LinkIdentity(userCtx, link, &proto.Account{}))
+	linked := &proto.Account{}
+	require.NoError(t, svc.LinkIdentity(serviceCtx(), link, linked))
+	require.Len(t, linked.Identities, 1)
+	assert.Equal(t, "federated", linked.Identities[0].SignInType)
+
+	a, err := resolve("einstein-sub")
+	require.NoError(t, err)
+	assert.Equal(t, einsteinID, a.Id)
+	assert.Empty(t, a.PasswordProfile.GetPassword())
+
+	// an identity is linked to one account only
+	assertCode(t, 409, svc.LinkIdentity(serviceCtx(), link, &proto.Account{}))
+	assertCode(t, 409, svc.LinkIdentity(serviceCtx(), &proto.LinkIdentityRequest{
+		AccountId: marieID,
+		Identity:  &proto.Identities{Issuer: testIssuer, IssuerAssignedId: "einstein-sub"},
+	}, &proto.Account{}))
+	assertCode(t, 409, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: marieID, Identities: []*proto.Identities{{Issuer: testIssuer, IssuerAssignedId: "einstein-sub"}}},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"Identities"}},
+	}, &proto.Account{}))
+
+	// the same subject of another issuer is a different identity
+	require.NoError(t, svc.LinkIdentity(serviceCtx(), &proto.LinkIdentityRequest{
+		AccountId: marieID,
+		Identity:  &proto.Identities{Issuer: "https://other.example.org", IssuerAssignedId: "einstein-sub"},
+	}, &proto.Account{}))
+
+	unlinked := &proto.Account{}
+	require.NoError(t, svc.UnlinkIdentity(serviceCtx(), &proto.UnlinkIdentityRequest{AccountId: einsteinID, Issuer: testIssuer, IssuerAssignedId: "einstein-sub"}, unlinked))
+	assert.Empty(t, unlinked.Identities)
+	_, err = resolve("einstein-sub")
+	assertCode(t, 404, err)
+	assertCode(t, 404, svc.UnlinkIdentity(serviceCtx(), &proto.UnlinkIdentityRequest{AccountId: einsteinID, Issuer: testIssuer, IssuerAssignedId: "einstein-sub"}, &proto.Account{}))
+}
+
+func TestProvisioning(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	svc.Config.Provisioning = config.Provisioning{
+		Enabled:          true,
+		UsernameClaim:    "preferred_username",
+		DisplayNameClaim: "name",
+		MailClaim:        "email",
+	}
+	claims := []*proto.Claim{
+		{Name: "preferred_username", Value: "lise"},
+		{Name: "name", Value: "Lise Meitner"},
+		{Name: "email", Value: "lise@example.org"},
+	}
+
+	assertCode(t, 400, svc.ResolveIdentity(serviceCtx(), &proto.ResolveIdentityRequest{Issuer: testIssuer, IssuerAssignedId: "lise-sub"}, &proto.Account{}))
+
+	created := &proto.Account{}
+	require.NoError(t, svc.ResolveIdentity(serviceCtx(), &proto.ResolveIdentityRequest{Issuer: testIssuer, IssuerAssignedId: "lise-sub", Claims: claims}, created))
+	assert.Equal(t, "lise", created.PreferredName)
+	assert.Equal(t, "Lise Meitner", created.DisplayName)
+	assert.Equal(t, "lise@example.org", created.Mail)
+	assert.True(t, created.AccountEnabled)
+
+	// the next sign in resolves the provisioned account
+	resolved := &proto.Account{}
+	require.NoError(t, svc.ResolveIdentity(serviceCtx(), &proto.ResolveIdentityRequest{Issuer: testIssuer, IssuerAssignedId: "lise-sub", Claims: claims}, resolved))
+	assert.Equal(t, created.Id, resolved.Id)
+
+	// existing accounts are not taken over by identities claiming their username
+	assertCode(t, 409, svc.ResolveIdentity(serviceCtx(), &proto.ResolveIdentityRequest{Issuer: testIssuer, IssuerAssignedId: "other-sub", Claims: claims}, &proto.Account{}))
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/tredoe/osutil/user/crypt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// userTypeMember and userTypeGuest are the values of the user_type of an account
+	userTypeMember = "Member"
+	userTypeGuest  = "Guest"
+	// creationTypeInvitation is the creation_type of invited guests
+	creationTypeInvitation = "Invitation"
+	// externalUserStatePending and externalUserStateAccepted are the states of the invitation of a guest
+	externalUserStatePending  = "PendingAcceptance"
+	externalUserStateAccepted = "Accepted"
+	// invitationSecretLength is the number of random bytes of the secret of a redemption token
+	invitationSecretLength = 32
+)
+
+// excludeGuests wraps the query so it does not match guests
+func excludeGuests(q bquery.Query) bquery.Query {
+	gq := bleve.NewTermQuery(userTypeGuest)
+	gq.SetField("user_type")
+
+	bq := bleve.NewBooleanQuery()
+	bq.AddMust(q)
+	bq.AddMustNot(gq)
+	return bq
+}
+
+// findPendingGuest loads the guest with the given mail whose invitation has not been redeemed yet
+func (s Service) findPendingGuest(ctx context.Context, mail string, a *proto.Account) (bool, error) {
+	accLock.Lock()
+	defer accLock.Unlock()
+	ids, err := s.findCandidates(ctx, "account", "mail", mail)
+	if err != nil {
+		return false, merrors.InternalServerError(s.id, "could not search guests: %v", err.Error())
+	}
+	for _, id := range ids {
+		if err := s.loadAccount(ctx, id, a); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not load account, skipping")
+			continue
+		}
+		if strings.EqualFold(a.Mail, mail) && a.ExternalUserState == externalUserStatePending && a.DeletedDateTime == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// InviteGuest implements the AccountsServiceHandler interface. Guests that have not redeemed their invitation yet are
+// invited again, their previous redemption token is no longer valid.
+func (s Service) InviteGuest(ctx context.Context, in *proto.InviteGuestRequest, out *proto.Invitation) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.InviteGuest")
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "InviteGuest", (*access).mayManageAccounts); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+	if !s.isValidEmail(in.Mail) {
+		return merrors.BadRequest(s.id, "mail '%s' must be a valid email", in.Mail)
+	}
+	now := time.Now()
+	expiration := in.ExpirationDateTime
+	if expiration == nil {
+		expiration = timestamppb.New(now.Add(s.Config.Invitations.ValidFor))
+	}
+	if expired(expiration, now) {
+		return merrors.BadRequest(s.id, "expiration_date_time must lie in the future")
+	}
+
+	guest := &proto.Account{}
+	var pending bool
+	if pending, err = s.findPendingGuest(ctx, in.Mail, guest); err != nil {
+		return
+	}
+	if pending {
+		if !acl.managesAccount(guest) {
+			return s.forbidden("InviteGuest")
+		}
+	} else {
+		name := in.PreferredName
+		if name == "" {
+			name = in.Mail
+		}
+		displayName := in.DisplayName
+		if displayName == "" {
+			displayName = in.Mail
+		}
+		// the creation is authorized and audited like any other account
+		if err = s.CreateAccount(ctx, &proto.CreateAccountRequest{Account: &proto.Account{
+			PreferredName:            name,
+			OnPremisesSamAccountName: name,
+			DisplayName:              displayName,
+			Mail:                     in.Mail,
+			AccountEnabled:           true,
+			UserType:                 userTypeGuest,
+			CreationType:             creationTypeInvitation,
+			ExternalUserState:        externalUserStatePending,
+		}}, guest); err != nil {
+			return
+		}
+	}
+	span.AddAttributes(trace.StringAttribute("account_id", guest.Id), trace.BoolAttribute("reinvited", pending))
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	a := &proto.Account{}
+	if err = s.loadAccount(ctx, guest.Id, a); err != nil {
+		s.log.Error().Err(err).Str("id", guest.Id).Msg("could not load guest")
+		return
+	}
+
+	var secret string
+	if secret, err = randomSecret(invitationSecretLength); err != nil {
+		return merrors.InternalServerError(s.id, "could not generate redemption token: %v", err.Error())
+	}
+	invitation := &proto.GuestInvitation{
+		CreatedDateTime:    timestamppb.New(now),
+		ExpirationDateTime: expiration,
+	}
+	c := crypt.New(crypt.SHA512)
+	if invitation.Hash, err = c.Generate([]byte(secret), nil); err != nil {
+		return merrors.InternalServerError(s.id, "could not hash redemption token: %v", err.Error())
+	}
+	a.Invitation = invitation
+	a.LastModifiedDateTime = invitation.CreatedDateTime
+
+	if err = s.writeAccount(ctx, a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist invitation")
+		return
+	}
+	if err = s.indexAccount(ctx, a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	s.audit(ctx, "invite", "account", a.Id, "", nil)
+
+	s.expandMemberOf(ctx, a)
+	hideSecrets(a)
+	out.InvitedUser = a
+	out.RedemptionToken = a.Id + "." + secret
+	out.ExpirationDateTime = expiration
+	return nil
+}
+
+// RedeemInvitation implements the AccountsServiceHandler interface. The token proves the guest was invited, so
+// services redeem invitations on behalf of guests that can not sign in yet. Unknown tokens, tokens of redeemed
+// invitations and of deleted accounts are rejected with the same error.
+func (s Service) RedeemInvitation(ctx context.Context, in *proto.RedeemInvitationRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.RedeemInvitation")
+	defer func() { endSpan(span, err) }()
+
+	if _, err = s.authorize(ctx, "RedeemInvitation", (*access).mayReadAccounts); err != nil {
+		return
+	}
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+	if in.Password == "" {
+		return merrors.BadRequest(s.id, "password missing")
+	}
+
+	invalid := merrors.Unauthorized(s.id, "invalid invitation")
+	i := strings.Index(in.Token, ".")
+	if i <= 0 {
+		return invalid
+	}
+	var id string
+	if id, err = cleanupID(in.Token[:i]); err != nil {
+		return invalid
+	}
+	secret := in.Token[i+1:]
+	span.AddAttributes(trace.StringAttribute("account_id", id))
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	if err = s.loadAccount(ctx, id, out); err != nil {
+		if merrors.FromError(err).GetCode() == 404 {
+			return invalid
+		}
+		return
+	}
+	if out.Invitation == nil || out.ExternalUserState != externalUserStatePending || out.DeletedDateTime != nil || !s.passwordIsValid(out.Invitation.Hash, secret) {
+		return invalid
+	}
+	now := timestamppb.Now()
+	if expired(out.Invitation.ExpirationDateTime, now.AsTime()) {
+		return merrors.BadRequest(s.id, "invitation has expired")
+	}
+
+	if out.PasswordProfile == nil {
+		out.PasswordProfile = &proto.PasswordProfile{}
+	}
+	c := crypt.New(crypt.SHA512)
+	if out.PasswordProfile.Password, err = c.Generate([]byte(in.Password), nil); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not hash password")
+		return merrors.InternalServerError(s.id, "could not hash password: %v", err.Error())
+	}
+	out.PasswordProfile.LastPasswordChangeDateTime = now
+	out.ExternalUserState = externalUserStateAccepted
+	out.ExternalUserStateChangeDateTime = now
+	out.Invitation = nil
+	out.LastModifiedDateTime = now
+
+	if err = s.writeAccount(ctx, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist redeemed invitation")
+		return
+	}
+	if err = s.indexAccount(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	s.audit(ctx, "redeem_invitation", "account", id, "", nil)
+
+	s.expandMemberOf(ctx, out)
+	hideSecrets(out)
+	return nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestInvitations(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	svc.Config.Invitations.ValidFor = time.Hour
+
+	// only administrators invite guests
+	userCtx := buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser})
+	assertForbidden(t, svc.InviteGuest(userCtx, &proto.InviteGuestRequest{Mail: "lise@example.org"}, &proto.Invitation{}))
+
+	first := &proto.Invitation{}
+	require.NoError(t, svc.InviteGuest(serviceCtx(), &proto.InviteGuestRequest{Mail: "lise@example.org", DisplayName: "Lise Meitner"}, first))
+	guest := first.InvitedUser
+	assert.Equal(t, "Guest", guest.UserType)
+	assert.Equal(t, "Invitation", guest.CreationType)
+	assert.Equal(t, "PendingAcceptance", guest.ExternalUserState)
+	assert.Equal(t, "Lise Meitner", guest.DisplayName)
+	assert.Empty(t, guest.Invitation.Hash)
+	assert.True(t, strings.HasPrefix(first.RedemptionToken, guest.Id+"."))
+	assert.WithinDuration(t, time.Now().Add(time.Hour), first.ExpirationDateTime.AsTime(), time.Minute)
+
+	// guests are not found by searches unless requested
+	list := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{}, list))
+	assert.NotContains(t, accountIDs(list.Accounts), guest.Id)
+	list = &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{ShowGuests: true}, list))
+	assert.Contains(t, accountIDs(list.Accounts), guest.Id)
+
+	// inviting a pending guest again replaces the token
+	second := &proto.Invitation{}
+	require.NoError(t, svc.InviteGuest(serviceCtx(), &proto.InviteGuestRequest{Mail: "lise@example.org"}, second))
+	assert.Equal(t, guest.Id, second.InvitedUser.Id)
+	redeem := func(token string) error {
+		return svc.RedeemInvitation(serviceCtx(), &proto.RedeemInvitationRequest{Token: token, Password: "fission"}, &proto.Account{})
+	}
+	assertCode(t, 401, redeem(first.RedemptionToken))
+	assertCode(t, 401, redeem(guest.Id+".wrong"))
+	assertCode(t, 401, redeem("unknown.token"))
+
+	// updating other properties keeps the invitation pending
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: guest.Id, DisplayName: "Lise"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName"}},
+	}, &proto.Account{}))
+	unmasked := &proto.Account{}
+	require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: guest.Id}, unmasked))
+	unmasked.DisplayName = "Lise Meitner"
+	unmasked.ExternalUserState = ""
+	updated := &proto.Account{}
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{Account: unmasked}, updated))
+	assert.Equal(t, "Lise Meitner", updated.DisplayName)
+	assert.Equal(t, "PendingAcceptance", updated.ExternalUserState)
+
+	redeemed := &proto.Account{}
+	require.NoError(t, svc.RedeemInvitation(serviceCtx(), &proto.RedeemInvitationRequest{Token: second.RedemptionToken, Password: "fission"}, redeemed))
+	assert.Equal(t, "Accepted", redeemed.ExternalUserState)
+	assert.Nil(t, redeemed.Invitation)
+	assert.Empty(t, redeemed.PasswordProfile.Password)
+	assertCode(t, 401, redeem(second.RedemptionToken))
+
+	// the guest signs in with the chosen password
+	list = &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{Query: "login eq 'lise@example.org' and password eq 'fission'"}, list))
+	require.Len(t, list.Accounts, 1)
+	assert.Equal(t, guest.Id, list.Accounts[0].Id)
+
+	// accepted guests and members are not invited again
+	assertCode(t, 409, svc.InviteGuest(serviceCtx(), &proto.InviteGuestRequest{Mail: "lise@example.org"}, &proto.Invitation{}))
+	assertCode(t, 409, svc.InviteGuest(serviceCtx(), &proto.InviteGuestRequest{Mail: "einstein@example.org"}, &proto.Invitation{}))
+}
+
+func TestExpiredInvitation(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	assertCode(t, 400, svc.InviteGuest(serviceCtx(), &proto.InviteGuestRequest{
+		Mail:               "otto@example.org",
+		ExpirationDateTime: timestamppb.New(time.Now().Add(-time.Minute)),
+	}, &proto.Invitation{}))
+
+	invitation := &proto.Invitation{}
+	require.NoError(t, svc.InviteGuest(serviceCtx(), &proto.InviteGuestRequest{
+		Mail:               "otto@example.org",
+		ExpirationDateTime: timestamppb.New(time.Now().Add(time.Second)),
+	}, invitation))
+	time.Sleep(time.Second)
+	assertCode(t, 400, svc.RedeemInvitation(serviceCtx(), &proto.RedeemInvitationRequest{Token: invitation.RedemptionToken, Password: "fission"}, &proto.Account{}))
+}
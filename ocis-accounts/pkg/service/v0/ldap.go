@@ -0,0 +1,87 @@
+package service
+
+import (
+	"errors"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/provider"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// errReadOnly is returned by all handlers that would modify accounts or groups served from ldap
+func (s Service) errReadOnly() error {
+	return merrors.MethodNotAllowed(s.id, "accounts and groups are read only when using the ldap backend")
+}
+
+// ldapError converts errors of the ldap provider to micro errors
+func (s Service) ldapError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		return merrors.NotFound(s.id, "%s", err)
+	case errors.Is(err, provider.ErrInvalidCredentials):
+		return merrors.Unauthorized(s.id, "invalid password")
+	default:
+		s.log.Error().Err(err).Msg("ldap request failed")
+		return merrors.InternalServerError(s.id, "ldap request failed: %v", err.Error())
+	}
+}
+
+// listLDAPAccounts answers ListAccounts requests, including auth requests, from ldap
+func (s Service) listLDAPAccounts(in *proto.ListAccountsRequest, out *proto.ListAccountsResponse) error {
+	if match := authQuery.FindStringSubmatch(in.Query); len(match) == 3 {
+		a, err := s.ldap.Authenticate(match[1], match[2])
+		if err != nil {
+			return s.ldapError(err)
+		}
+		out.Accounts = []*proto.Account{a}
+		return nil
+	}
+
+	accounts, err := s.ldap.ListAccounts(in.Query)
+	if err != nil {
+		return s.ldapError(err)
+	}
+	out.Accounts = accounts
+	return nil
+}
+
+// getLDAPAccount answers GetAccount requests from ldap
+func (s Service) getLDAPAccount(in *proto.GetAccountRequest, out *proto.Account) error {
+	a, err := s.ldap.GetAccount(in.Id)
+	if err != nil {
+		return s.ldapError(err)
+	}
+	gproto.Merge(out, a)
+	return nil
+}
+
+// listLDAPGroups answers ListGroups requests from ldap
+func (s Service) listLDAPGroups(in *proto.ListGroupsRequest, out *proto.ListGroupsResponse) error {
+	groups, err := s.ldap.ListGroups(in.Query)
+	if err != nil {
+		return s.ldapError(err)
+	}
+	out.Groups = groups
+	return nil
+}
+
+// getLDAPGroup answers GetGroup requests from ldap
+func (s Service) getLDAPGroup(in *proto.GetGroupRequest, out *proto.Group) error {
+	g, err := s.ldap.GetGroup(in.Id)
+	if err != nil {
+		return s.ldapError(err)
+	}
+	gproto.Merge(out, g)
+	return nil
+}
+
+// listLDAPMembers answers ListMembers requests from ldap
+func (s Service) listLDAPMembers(in *proto.ListMembersRequest, out *proto.ListMembersResponse) error {
+	members, err := s.ldap.ListMembers(in.Id)
+	if err != nil {
+		return s.ldapError(err)
+	}
+	out.Members = members
+	return nil
+}
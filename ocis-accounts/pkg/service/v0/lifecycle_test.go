@@ -0,0 +1,64 @@
+package service
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLifecycleTimestamps(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	get := func(id string) *proto.Account {
+		a := &proto.Account{}
+		require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: id}, a))
+		return a
+	}
+	hourAgo := time.Now().Add(-time.Hour)
+
+	// timestamps sent by clients are ignored
+	created := &proto.Account{}
+	require.NoError(t, svc.CreateAccount(serviceCtx(), &proto.CreateAccountRequest{Account: &proto.Account{
+		PreferredName:            "ada",
+		OnPremisesSamAccountName: "ada",
+		Mail:                     "ada@example.org",
+		AccountEnabled:           true,
+		CreatedDateTime:          timestamppb.New(hourAgo),
+		LastSignInDateTime:       timestamppb.New(hourAgo),
+		PasswordProfile:          &proto.PasswordProfile{Password: "Analytical1!"},
+	}}, created))
+	require.NotNil(t, created.CreatedDateTime)
+	assert.True(t, created.CreatedDateTime.AsTime().After(hourAgo))
+	assert.Equal(t, created.CreatedDateTime.AsTime(), created.LastModifiedDateTime.AsTime())
+	assert.Nil(t, created.LastSignInDateTime)
+	require.NotNil(t, created.PasswordProfile.LastPasswordChangeDateTime)
+
+	require.NoError(t, signIn(svc, "ada", "Analytical1!"))
+	assert.NotNil(t, get(created.Id).LastSignInDateTime)
+
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: created.Id, DisplayName: "Ada Lovelace"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"DisplayName"}},
+	}, &proto.Account{}))
+	updated := get(created.Id)
+	assert.Equal(t, created.CreatedDateTime.AsTime(), updated.CreatedDateTime.AsTime())
+	assert.False(t, updated.LastModifiedDateTime.AsTime().Before(created.LastModifiedDateTime.AsTime()))
+
+	// the timestamps are indexed, e.g. to find accounts that have not signed in for a while
+	list := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{
+		Query: "created_date_time ge " + hourAgo.UTC().Format(time.RFC3339),
+	}, list))
+	assert.Contains(t, accountIDs(list.Accounts), created.Id)
+	list = &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{
+		Query: "last_sign_in_date_time lt " + hourAgo.UTC().Format(time.RFC3339),
+	}, list))
+	assert.NotContains(t, accountIDs(list.Accounts), created.Id)
+}
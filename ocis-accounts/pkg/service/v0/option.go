@@ -1,10 +1,12 @@
 package service
 
 import (
+	"github.com/micro/go-micro/v2/broker"
 	"github.com/owncloud/ocis-pkg/v2/log"
 	"github.com/owncloud/ocis-pkg/v2/roles"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/metrics"
 )
 
 // Option defines a single option function.
@@ -16,6 +18,8 @@ type Options struct {
 	Config      *config.Config
 	RoleService settings.RoleService
 	RoleManager *roles.Manager
+	Broker      broker.Broker
+	Metrics     *metrics.Metrics
 }
 
 func newOptions(opts ...Option) Options {
@@ -55,3 +59,17 @@ func RoleManager(val *roles.Manager) Option {
 		o.RoleManager = val
 	}
 }
+
+// Broker provides a function to set the broker option. Events are only published when a broker is set.
+func Broker(val broker.Broker) Option {
+	return func(o *Options) {
+		o.Broker = val
+	}
+}
+
+// Metrics provides a function to set the metrics option.
+func Metrics(val *metrics.Metrics) Option {
+	return func(o *Options) {
+		o.Metrics = val
+	}
+}
@@ -7,6 +7,7 @@ import (
 	olog "github.com/owncloud/ocis-pkg/v2/log"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"go.opencensus.io/trace"
 )
 
 const (
@@ -18,24 +19,42 @@ const (
 	GroupManagementPermissionID     string = "522adfbe-5908-45b4-b135-41979de73245"
 	// GroupManagementPermissionName is the hardcoded setting name for the group management permission
 	GroupManagementPermissionName   string = "group-management"
+	// DirectoryReadPermissionID is the hardcoded setting UUID for the directory read permission
+	DirectoryReadPermissionID string = "3b0e9ee5-8b9e-4d8a-9b0c-7c2ec7b8a8d1"
+	// DirectoryReadPermissionName is the hardcoded setting name for the directory read permission
+	DirectoryReadPermissionName string = "directory-read"
+	// GroupMemberManagementPermissionID is the hardcoded setting UUID for the group member management permission
+	GroupMemberManagementPermissionID string = "f2d6a1c4-3e57-4b8e-a0d9-5c1b7e4f9a26"
+	// GroupMemberManagementPermissionName is the hardcoded setting name for the group member management permission
+	GroupMemberManagementPermissionName string = "group-member-management"
+	// ScopedAccountManagementPermissionID is the hardcoded setting UUID for the scoped account management permission
+	ScopedAccountManagementPermissionID string = "6a4c8e0f-92b1-4d37-b5e8-1f3d7a9c2e54"
+	// ScopedAccountManagementPermissionName is the hardcoded setting name for the scoped account management permission
+	ScopedAccountManagementPermissionName string = "scoped-account-management"
 )
 
-// RegisterPermissions registers permissions for account management and group management with the settings service.
-func RegisterPermissions(l *olog.Logger) {
+// RegisterPermissions registers the permissions for account management and group management with the settings service.
+// The admin role holds all of them, roles for delegated administration can be built from the scoped ones. It returns
+// the last error, failed requests can be repeated.
+func RegisterPermissions(l *olog.Logger) (err error) {
 	// TODO this won't work with a registry other than mdns. Look into Micro's client initialization.
 	// https://github.com/owncloud/ocis-proxy/issues/38
 	service := settings.NewBundleService("com.owncloud.api.settings", mclient.DefaultClient)
 
 	permissionRequests := generateAccountManagementPermissionsRequests()
 	for i := range permissionRequests {
-		res, err := service.AddSettingToBundle(context.Background(), &permissionRequests[i])
+		ctx, span := startSettingsSpan(context.Background(), "AddSettingToBundle", trace.StringAttribute("setting_id", permissionRequests[i].Setting.Id))
+		res, e := service.AddSettingToBundle(ctx, &permissionRequests[i])
+		endSpan(span, e)
 		bundleID := permissionRequests[i].BundleId
-		if err != nil {
+		if e != nil {
+			err = e
 			l.Err(err).Str("bundle", bundleID).Str("setting", permissionRequests[i].Setting.Id).Msg("error adding setting to bundle")
 		} else {
 			l.Info().Str("bundle", bundleID).Str("setting", res.Setting.Id).Msg("successfully added setting to bundle")
 		}
 	}
+	return
 }
 
 func generateAccountManagementPermissionsRequests() []settings.AddSettingToBundleRequest {
@@ -78,5 +97,62 @@ func generateAccountManagementPermissionsRequests() []settings.AddSettingToBundl
 				},
 			},
 		},
+		{
+			BundleId: ssvc.BundleUUIDRoleAdmin,
+			Setting: &settings.Setting{
+				Id:          DirectoryReadPermissionID,
+				Name:        DirectoryReadPermissionName,
+				DisplayName: "Directory Read",
+				Description: "This permission gives read access to all accounts and groups.",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SYSTEM,
+					Id:   "all",
+				},
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READ,
+						Constraint: settings.Permission_CONSTRAINT_ALL,
+					},
+				},
+			},
+		},
+		{
+			BundleId: ssvc.BundleUUIDRoleAdmin,
+			Setting: &settings.Setting{
+				Id:          GroupMemberManagementPermissionID,
+				Name:        GroupMemberManagementPermissionName,
+				DisplayName: "Group Member Management",
+				Description: "This permission allows managing the members of groups. It can be limited to the group the resource id names. Owners manage the members of their groups without it.",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_GROUP,
+					Id:   "all",
+				},
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READWRITE,
+						Constraint: settings.Permission_CONSTRAINT_ALL,
+					},
+				},
+			},
+		},
+		{
+			BundleId: ssvc.BundleUUIDRoleAdmin,
+			Setting: &settings.Setting{
+				Id:          ScopedAccountManagementPermissionID,
+				Name:        ScopedAccountManagementPermissionName,
+				DisplayName: "Scoped Account Management",
+				Description: "This permission allows managing the accounts that are members of the group the resource id names.",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_GROUP,
+					Id:   "all",
+				},
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READWRITE,
+						Constraint: settings.Permission_CONSTRAINT_ALL,
+					},
+				},
+			},
+		},
 	}
 }
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blevesearch/bleve"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// numberPageSize is the number of used uid or gid numbers read at once when looking for a free one
+var numberPageSize = 1000
+
+// posixLock serializes allocating uid and gid numbers with indexing the records that use them
+var posixLock sync.Mutex
+
+// assignUIDNumber allocates a uid number for a new account that has none and rejects numbers used by other accounts
+func (s Service) assignUIDNumber(ctx context.Context, a *proto.Account) (err error) {
+	if a.UidNumber < 0 || a.GidNumber < 0 {
+		return merrors.BadRequest(s.id, "uid_number and gid_number must not be negative")
+	}
+	if a.GidNumber == 0 {
+		a.GidNumber = s.Config.Posix.DefaultGID
+	}
+	if a.UidNumber == 0 {
+		if a.UidNumber, err = s.nextFreeNumber(ctx, "account", "uid_number", s.Config.Posix.UID); err != nil {
+			return merrors.InternalServerError(s.id, "could not allocate uid_number: %v", err.Error())
+		}
+		return nil
+	}
+	return s.checkNumber(ctx, "account", "uid_number", a.UidNumber, a.Id)
+}
+
+// assignGIDNumber allocates a gid number for a new group that has none and rejects numbers used by other groups
+func (s Service) assignGIDNumber(ctx context.Context, g *proto.Group) (err error) {
+	if g.GidNumber < 0 {
+		return merrors.BadRequest(s.id, "gid_number must not be negative")
+	}
+	if g.GidNumber == 0 {
+		if g.GidNumber, err = s.nextFreeNumber(ctx, "group", "gid_number", s.Config.Posix.GID); err != nil {
+			return merrors.InternalServerError(s.id, "could not allocate gid_number: %v", err.Error())
+		}
+		return nil
+	}
+	return s.checkNumber(ctx, "group", "gid_number", g.GidNumber, g.Id)
+}
+
+// checkNumber returns a conflict error if the number is already used by another document of the given type
+func (s Service) checkNumber(ctx context.Context, bleveType, field string, n int64, id string) error {
+	owner, err := s.numberOwner(ctx, bleveType, field, n, id)
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not check %s: %v", field, err.Error())
+	}
+	if owner != "" {
+		return merrors.Conflict(s.id, "%s %d is already used by %s %s", field, n, bleveType, owner)
+	}
+	return nil
+}
+
+// numberOwner returns the id of another document of the given type that already uses the number, or an empty string
+func (s Service) numberOwner(ctx context.Context, bleveType, field string, n int64, id string) (string, error) {
+	tq := bleve.NewTermQuery(bleveType)
+	tq.SetField("bleve_type")
+
+	v := float64(n)
+	incl := true
+	nq := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
+	nq.SetField(field)
+
+	// deleted records keep their numbers, they might be restored
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(tq, nq))
+	searchResult, err := s.search(ctx, searchRequest)
+	if err != nil {
+		return "", err
+	}
+
+	for _, hit := range searchResult.Hits {
+		if hit.ID != id {
+			return hit.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// nextFreeNumber returns the lowest number in the bound that is not used by any document of the given type
+func (s Service) nextFreeNumber(ctx context.Context, bleveType, field string, b config.Bound) (int64, error) {
+	if b.Lower <= 0 || b.Upper < b.Lower {
+		return 0, fmt.Errorf("invalid range %d-%d for %s", b.Lower, b.Upper, field)
+	}
+
+	tq := bleve.NewTermQuery(bleveType)
+	tq.SetField("bleve_type")
+
+	min := float64(b.Lower)
+	max := float64(b.Upper)
+	incl := true
+	rq := bleve.NewNumericRangeInclusiveQuery(&min, &max, &incl, &incl)
+	rq.SetField(field)
+
+	// the used numbers are read in pages sorted ascending, the first gap is the next free number
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(tq, rq))
+	searchRequest.Size = numberPageSize
+	searchRequest.Fields = []string{field}
+	searchRequest.SortBy([]string{field})
+
+	next := b.Lower
+	for {
+		searchResult, err := s.search(ctx, searchRequest)
+		if err != nil {
+			return 0, err
+		}
+		for _, hit := range searchResult.Hits {
+			v, ok := hit.Fields[field].(float64)
+			if !ok {
+				continue
+			}
+			if int64(v) > next {
+				return next, nil
+			}
+			if int64(v) == next {
+				next++
+			}
+		}
+		if len(searchResult.Hits) < searchRequest.Size {
+			break
+		}
+		searchRequest.From += searchRequest.Size
+	}
+
+	if next > b.Upper {
+		return 0, fmt.Errorf("all numbers in range %d-%d for %s are taken", b.Lower, b.Upper, field)
+	}
+	return next, nil
+}
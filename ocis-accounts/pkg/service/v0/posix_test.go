@@ -0,0 +1,60 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextFreeNumber(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	svc.Config.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 20009}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+
+	// the used numbers span several pages
+	defer func(size int) { numberPageSize = size }(numberPageSize)
+	numberPageSize = 2
+
+	// the demo users use 20000 to 20003
+	n, err := svc.nextFreeNumber(serviceCtx(), "account", "uid_number", svc.Config.Posix.UID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20004, n)
+
+	create := func(name string, uid int64) (*proto.Account, error) {
+		a := &proto.Account{}
+		err := svc.CreateAccount(serviceCtx(), &proto.CreateAccountRequest{Account: &proto.Account{
+			PreferredName:            name,
+			OnPremisesSamAccountName: name,
+			Mail:                     name + "@example.org",
+			UidNumber:                uid,
+			AccountEnabled:           true,
+		}}, a)
+		return a, err
+	}
+
+	// gaps are filled first
+	_, err = create("gap", 20005)
+	require.NoError(t, err)
+	a, err := create("first", 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20004, a.UidNumber)
+	assert.EqualValues(t, 30000, a.GidNumber)
+	a, err = create("second", 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20006, a.UidNumber)
+
+	// used numbers are rejected
+	_, err = create("duplicate", 20006)
+	assertCode(t, 409, err)
+
+	for _, name := range []string{"third", "fourth", "fifth"} {
+		_, err = create(name, 0)
+		require.NoError(t, err)
+	}
+	_, err = create("overflow", 0)
+	assertCode(t, 500, err)
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// excludeDeleted wraps a query so that it no longer matches deleted accounts or groups
+func excludeDeleted(q bquery.Query) bquery.Query {
+	min := float64(1)
+	inclusive := true
+	dq := bleve.NewNumericRangeInclusiveQuery(&min, nil, &inclusive, nil)
+	dq.SetField("deleted_date_time.seconds")
+
+	bq := bleve.NewBooleanQuery()
+	bq.AddMust(q)
+	bq.AddMustNot(dq)
+	return bq
+}
+
+// deletedBefore checks if a deleted date time lies before the given time
+func deletedBefore(ts *timestamppb.Timestamp, t time.Time) bool {
+	return ts != nil && ts.AsTime().Before(t)
+}
+
+// RunPurge periodically purges accounts and groups whose retention period has expired. It blocks until ctx is done.
+func (s Service) RunPurge(ctx context.Context) {
+	if s.Config.Server.PurgeInterval <= 0 {
+		s.log.Info().Msg("purging deleted accounts and groups is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.Server.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			before := time.Now().Add(-s.Config.Server.DeletedRetention)
+			purgeCtx, span := startSpan(ctx, "purge")
+			s.purgeAccounts(purgeCtx, before)
+			s.purgeGroups(purgeCtx, before)
+			span.End()
+		}
+	}
+}
+
+// purgeAccounts removes all accounts that have been deleted before the given time
+func (s Service) purgeAccounts(ctx context.Context, before time.Time) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	dir := filepath.Join(s.Config.Server.AccountsDataPath, "accounts")
+	list, err := ioutil.ReadDir(dir)
+	if err != nil {
+		s.log.Error().Err(err).Str("dir", dir).Msg("could not list accounts folder")
+		return
+	}
+
+	purged := map[string]bool{}
+	for _, file := range list {
+		a := &proto.Account{}
+		if err := s.loadAccount(ctx, file.Name(), a); err != nil {
+			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not load account, skipping")
+			continue
+		}
+		if !deletedBefore(a.DeletedDateTime, before) {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+		start := time.Now()
+		err := os.Remove(path)
+		s.metrics.ObserveStorage("remove", "account", start)
+		if err != nil {
+			s.log.Error().Err(err).Str("id", file.Name()).Str("path", path).Msg("could not purge account")
+			continue
+		}
+		if err := s.index.Delete(file.Name()); err != nil {
+			s.log.Error().Err(err).Str("id", file.Name()).Msg("could not remove purged account from index")
+		}
+
+		purged[file.Name()] = true
+		s.audit(context.Background(), "purge", "account", file.Name(), "", nil)
+		s.log.Info().Str("id", file.Name()).Msg("purged account")
+	}
+	s.countDocuments(ctx, "account")
+	if len(purged) > 0 {
+		s.unlinkPurgedAccounts(ctx, purged)
+	}
+}
+
+// unlinkPurgedAccounts removes the purged accounts from the members and owners of all groups. Deleted accounts are
+// removed from the members when they are deleted, but they stay owners so they can be restored.
+func (s Service) unlinkPurgedAccounts(ctx context.Context, purged map[string]bool) {
+	dir := filepath.Join(s.Config.Server.AccountsDataPath, "groups")
+	list, err := ioutil.ReadDir(dir)
+	if err != nil {
+		s.log.Error().Err(err).Str("dir", dir).Msg("could not list groups folder")
+		return
+	}
+
+	keep := func(refs []*proto.Account) []*proto.Account {
+		kept := []*proto.Account{}
+		for i := range refs {
+			if !purged[refs[i].Id] {
+				kept = append(kept, refs[i])
+			}
+		}
+		return kept
+	}
+	for _, file := range list {
+		g := &proto.Group{}
+		if err := s.loadGroup(ctx, file.Name(), g); err != nil {
+			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not load group, skipping")
+			continue
+		}
+		members, owners := keep(g.Members), keep(g.Owners)
+		if len(members) == len(g.Members) && len(owners) == len(g.Owners) {
+			continue
+		}
+		g.Members, g.Owners = members, owners
+		if err := s.writeGroup(ctx, g); err != nil {
+			s.log.Error().Err(err).Str("id", g.Id).Msg("could not remove purged accounts from group")
+			continue
+		}
+		// the owners are indexed for ListOwnedGroups
+		if err := s.indexGroup(ctx, g.Id); err != nil {
+			s.log.Error().Err(err).Str("id", g.Id).Msg("could not index group")
+		}
+		s.log.Info().Str("id", g.Id).Msg("removed purged accounts from group")
+	}
+}
+
+// purgeGroups removes all groups that have been deleted before the given time
+func (s Service) purgeGroups(ctx context.Context, before time.Time) {
+	dir := filepath.Join(s.Config.Server.AccountsDataPath, "groups")
+	list, err := ioutil.ReadDir(dir)
+	if err != nil {
+		s.log.Error().Err(err).Str("dir", dir).Msg("could not list groups folder")
+		return
+	}
+
+	for _, file := range list {
+		g := &proto.Group{}
+		if err := s.loadGroup(ctx, file.Name(), g); err != nil {
+			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not load group, skipping")
+			continue
+		}
+		if !deletedBefore(g.DeletedDateTime, before) {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+		groupLock.Lock()
+		start := time.Now()
+		err := os.Remove(path)
+		s.metrics.ObserveStorage("remove", "group", start)
+		groupLock.Unlock()
+		if err != nil {
+			s.log.Error().Err(err).Str("id", file.Name()).Str("path", path).Msg("could not purge group")
+			continue
+		}
+		if err := s.index.Delete(file.Name()); err != nil {
+			s.log.Error().Err(err).Str("id", file.Name()).Msg("could not remove purged group from index")
+		}
+
+		s.audit(context.Background(), "purge", "group", file.Name(), "", nil)
+		s.log.Info().Str("id", file.Name()).Msg("purged group")
+	}
+	s.countDocuments(ctx, "group")
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func TestDeleteAndRestoreAccount(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	listed := func(showDeleted bool) []string {
+		out := &proto.ListAccountsResponse{}
+		require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{ShowDeleted: showDeleted}, out))
+		return accountIDs(out.Accounts)
+	}
+	members := func(groupID string) []string {
+		out := &proto.ListMembersResponse{}
+		require.NoError(t, svc.ListMembers(serviceCtx(), &proto.ListMembersRequest{Id: groupID}, out))
+		return accountIDs(out.Members)
+	}
+
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: einsteinID}, &empty.Empty{}))
+	deleted := &proto.Account{}
+	require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: einsteinID}, deleted))
+	assert.False(t, deleted.AccountEnabled)
+	assert.NotNil(t, deleted.DeletedDateTime)
+	assert.NotContains(t, listed(false), einsteinID)
+	assert.Contains(t, listed(true), einsteinID)
+	assert.NotContains(t, members(usersID), einsteinID)
+	assert.Error(t, signIn(svc, "einstein", "relativity"))
+
+	// deleting twice keeps the first deletion date
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: einsteinID}, &empty.Empty{}))
+	again := &proto.Account{}
+	require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: einsteinID}, again))
+	assert.True(t, deleted.DeletedDateTime.AsTime().Equal(again.DeletedDateTime.AsTime()))
+
+	restored := &proto.Account{}
+	require.NoError(t, svc.RestoreAccount(serviceCtx(), &proto.RestoreAccountRequest{Id: einsteinID}, restored))
+	assert.True(t, restored.AccountEnabled)
+	assert.Nil(t, restored.DeletedDateTime)
+	assert.ElementsMatch(t, []string{usersID, sailingLoversID, violinHatersID, physicsLoversID}, groupIDs(restored.MemberOf))
+	assert.Contains(t, listed(false), einsteinID)
+	assert.Contains(t, members(usersID), einsteinID)
+	assert.NoError(t, signIn(svc, "einstein", "relativity"))
+
+	assertCode(t, 400, svc.RestoreAccount(serviceCtx(), &proto.RestoreAccountRequest{Id: einsteinID}, &proto.Account{}))
+}
+
+func TestRestoreAccountKeepsState(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	// accounts that were disabled before they were deleted are restored disabled
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: marieID, AccountEnabled: false},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+	}, &proto.Account{}))
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: marieID}, &empty.Empty{}))
+	restored := &proto.Account{}
+	require.NoError(t, svc.RestoreAccount(serviceCtx(), &proto.RestoreAccountRequest{Id: marieID}, restored))
+	assert.False(t, restored.AccountEnabled)
+	assert.False(t, restored.DeletedAccountEnabled)
+
+	// accounts whose login has been taken over in the meantime are not restored
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: einsteinID}, &empty.Empty{}))
+	require.NoError(t, svc.writeAccount(serviceCtx(), &proto.Account{Id: "legacy", OnPremisesSamAccountName: "einstein"}))
+	require.NoError(t, svc.indexAccount(serviceCtx(), "legacy"))
+	assertCode(t, 409, svc.RestoreAccount(serviceCtx(), &proto.RestoreAccountRequest{Id: einsteinID}, &proto.Account{}))
+	deleted := &proto.Account{}
+	require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: einsteinID}, deleted))
+	assert.NotNil(t, deleted.DeletedDateTime)
+	assert.True(t, deleted.DeletedAccountEnabled)
+}
+
+func TestDeleteAndRestoreGroup(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	listed := func(showDeleted bool) []string {
+		out := &proto.ListGroupsResponse{}
+		require.NoError(t, svc.ListGroups(serviceCtx(), &proto.ListGroupsRequest{ShowDeleted: showDeleted}, out))
+		return groupIDs(out.Groups)
+	}
+	memberOf := func(accountID string) []string {
+		a := &proto.Account{}
+		require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: accountID}, a))
+		return groupIDs(a.MemberOf)
+	}
+
+	require.NoError(t, svc.DeleteGroup(serviceCtx(), &proto.DeleteGroupRequest{Id: sailingLoversID}, &empty.Empty{}))
+	assert.NotContains(t, listed(false), sailingLoversID)
+	assert.Contains(t, listed(true), sailingLoversID)
+	assert.NotContains(t, memberOf(einsteinID), sailingLoversID)
+
+	restored := &proto.Group{}
+	require.NoError(t, svc.RestoreGroup(serviceCtx(), &proto.RestoreGroupRequest{Id: sailingLoversID}, restored))
+	assert.Nil(t, restored.DeletedDateTime)
+	assert.Contains(t, accountIDs(restored.Members), einsteinID)
+	assert.Contains(t, listed(false), sailingLoversID)
+	assert.Contains(t, memberOf(einsteinID), sailingLoversID)
+
+	assertCode(t, 400, svc.RestoreGroup(serviceCtx(), &proto.RestoreGroupRequest{Id: sailingLoversID}, &proto.Group{}))
+}
+
+func TestPurgeAfterRetention(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: marieID}, &empty.Empty{}))
+	require.NoError(t, svc.DeleteGroup(serviceCtx(), &proto.DeleteGroupRequest{Id: violinHatersID}, &empty.Empty{}))
+
+	// the retention period has not passed yet
+	svc.purgeAccounts(serviceCtx(), time.Now().Add(-time.Hour))
+	svc.purgeGroups(serviceCtx(), time.Now().Add(-time.Hour))
+	assert.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: marieID}, &proto.Account{}))
+	assert.NoError(t, svc.GetGroup(serviceCtx(), &proto.GetGroupRequest{Id: violinHatersID}, &proto.Group{}))
+
+	svc.purgeAccounts(serviceCtx(), time.Now().Add(time.Minute))
+	svc.purgeGroups(serviceCtx(), time.Now().Add(time.Minute))
+	assert.Error(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: marieID}, &proto.Account{}))
+	assert.Error(t, svc.GetGroup(serviceCtx(), &proto.GetGroupRequest{Id: violinHatersID}, &proto.Group{}))
+
+	// accounts that are not deleted are kept
+	assert.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: einsteinID}, &proto.Account{}))
+	out := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{ShowDeleted: true}, out))
+	assert.NotContains(t, accountIDs(out.Accounts), marieID)
+}
+
+func TestPurgeRemovesMemberships(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	require.NoError(t, svc.AddOwner(serviceCtx(), &proto.AddOwnerRequest{GroupId: sailingLoversID, AccountId: einsteinID}, &proto.Group{}))
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: einsteinID}, &empty.Empty{}))
+
+	// a member reference that has been left behind, e.g. by an earlier version
+	g := &proto.Group{}
+	require.NoError(t, svc.loadGroup(serviceCtx(), usersID, g))
+	g.Members = append(g.Members, &proto.Account{Id: einsteinID})
+	require.NoError(t, svc.writeGroup(serviceCtx(), g))
+
+	svc.purgeAccounts(serviceCtx(), time.Now().Add(time.Minute))
+
+	for _, id := range []string{usersID, sailingLoversID} {
+		g := &proto.Group{}
+		require.NoError(t, svc.loadGroup(serviceCtx(), id, g))
+		assert.NotContains(t, accountIDs(g.Members), einsteinID)
+		assert.NotContains(t, accountIDs(g.Owners), einsteinID)
+	}
+
+	members := &proto.ListMembersResponse{}
+	require.NoError(t, svc.ListMembers(serviceCtx(), &proto.ListMembersRequest{Id: usersID}, members))
+	assert.NotContains(t, accountIDs(members.Members), einsteinID)
+	assert.Contains(t, accountIDs(members.Members), marieID)
+
+	owned := &proto.ListGroupsResponse{}
+	require.NoError(t, svc.ListOwnedGroups(serviceCtx(), &proto.ListOwnedGroupsRequest{AccountId: einsteinID}, owned))
+	assert.Empty(t, owned.Groups)
+}
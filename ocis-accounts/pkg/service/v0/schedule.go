@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunSchedule periodically disables expired accounts and enables accounts whose scheduled date has come. It blocks
+// until ctx is done.
+func (s Service) RunSchedule(ctx context.Context) {
+	if s.Config.Server.ScheduleInterval <= 0 {
+		s.log.Info().Msg("expiring and enabling accounts on schedule is disabled")
+		return
+	}
+	if s.ldap != nil {
+		// accounts read from ldap are enabled and disabled in the directory
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.Server.ScheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			scheduleCtx, span := startSpan(ctx, "schedule")
+			s.applySchedule(scheduleCtx, now)
+			span.End()
+		}
+	}
+}
+
+// applySchedule enables the accounts scheduled to be enabled and disables the accounts that have expired at the
+// given time. Scheduled enables are applied first, so an account that is due for both ends up disabled.
+func (s Service) applySchedule(ctx context.Context, now time.Time) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	ids, err := s.findDue(ctx, "scheduled_enable_date_time", now, false)
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not search accounts scheduled to be enabled")
+	}
+	for _, id := range ids {
+		a := &proto.Account{}
+		if err := s.loadAccount(ctx, id, a); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not load account, skipping")
+			continue
+		}
+		if !expired(a.ScheduledEnableDateTime, now) || a.DeletedDateTime != nil {
+			continue
+		}
+		// the schedule is cleared even if the account expired meanwhile, it is not enabled again by a later run
+		a.ScheduledEnableDateTime = nil
+		enable := !a.AccountEnabled && !expired(a.ExpirationDateTime, now)
+		if enable {
+			a.AccountEnabled = true
+		}
+		a.LastModifiedDateTime = timestamppb.New(now)
+		if !s.persistScheduled(ctx, a) {
+			continue
+		}
+		if enable {
+			s.audit(context.Background(), "scheduled_enable", "account", id, "", []string{"AccountEnabled", "ScheduledEnableDateTime"})
+			s.log.Info().Str("id", id).Msg("enabled account on schedule")
+		}
+	}
+
+	if ids, err = s.findDue(ctx, "expiration_date_time", now, true); err != nil {
+		s.log.Error().Err(err).Msg("could not search expired accounts")
+		return
+	}
+	for _, id := range ids {
+		a := &proto.Account{}
+		if err := s.loadAccount(ctx, id, a); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not load account, skipping")
+			continue
+		}
+		if !a.AccountEnabled || !expired(a.ExpirationDateTime, now) || a.DeletedDateTime != nil {
+			continue
+		}
+		ts := timestamppb.New(now)
+		a.AccountEnabled = false
+		a.LastModifiedDateTime = ts
+		revokeSessions(a, ts)
+		if !s.persistScheduled(ctx, a) {
+			continue
+		}
+		s.audit(context.Background(), "expire", "account", id, "", []string{"AccountEnabled"})
+		s.log.Info().Str("id", id).Msg("disabled expired account")
+	}
+}
+
+// persistScheduled writes and indexes an account changed by the schedule, errors are logged
+func (s Service) persistScheduled(ctx context.Context, a *proto.Account) bool {
+	if err := s.writeAccount(ctx, a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist scheduled change")
+		return false
+	}
+	if err := s.indexAccount(ctx, a.Id); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not index account")
+	}
+	return true
+}
+
+// findDue returns the ids of all accounts that are not deleted and whose timestamp in the field lies at or before
+// the given time. With enabledOnly only enabled accounts are returned.
+func (s Service) findDue(ctx context.Context, field string, now time.Time, enabledOnly bool) ([]string, error) {
+	tq := bleve.NewTermQuery("account")
+	tq.SetField("bleve_type")
+
+	max := float64(now.Unix())
+	inclusive := true
+	dq := bleve.NewNumericRangeInclusiveQuery(nil, &max, nil, &inclusive)
+	dq.SetField(field + ".seconds")
+
+	queries := []bquery.Query{tq, dq}
+	if enabledOnly {
+		// false values are not indexed, so only the enabled accounts can be matched
+		eq := bleve.NewBoolFieldQuery(true)
+		eq.SetField("account_enabled")
+		queries = append(queries, eq)
+	}
+
+	searchRequest := bleve.NewSearchRequest(excludeDeleted(bleve.NewConjunctionQuery(queries...)))
+	searchRequest.Size = 100
+
+	ids := []string{}
+	for {
+		searchResult, err := s.search(ctx, searchRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range searchResult.Hits {
+			ids = append(ids, hit.ID)
+		}
+		if len(searchResult.Hits) < searchRequest.Size {
+			return ids, nil
+		}
+		searchRequest.From += searchRequest.Size
+	}
+}
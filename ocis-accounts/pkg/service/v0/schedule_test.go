@@ -0,0 +1,83 @@
+package service
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSchedule(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	now := time.Now()
+	update := func(a *proto.Account, paths ...string) error {
+		return svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+			Account:    a,
+			UpdateMask: &field_mask.FieldMask{Paths: paths},
+		}, &proto.Account{})
+	}
+	get := func(id string) *proto.Account {
+		a := &proto.Account{}
+		require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: id}, a))
+		return a
+	}
+
+	// einstein expires in an hour, marie is disabled until then
+	require.NoError(t, update(&proto.Account{Id: einsteinID, ExpirationDateTime: timestamppb.New(now.Add(time.Hour))}, "ExpirationDateTime"))
+	require.NoError(t, update(&proto.Account{Id: marieID, AccountEnabled: false}, "AccountEnabled"))
+	require.NoError(t, update(&proto.Account{Id: marieID, ScheduledEnableDateTime: timestamppb.New(now.Add(time.Hour))}, "ScheduledEnableDateTime"))
+
+	list := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{
+		Query: "expiration_date_time lt " + now.Add(2*time.Hour).UTC().Format(time.RFC3339),
+	}, list))
+	assert.Equal(t, []string{einsteinID}, accountIDs(list.Accounts))
+
+	// nothing is due yet
+	svc.applySchedule(serviceCtx(), now)
+	assert.True(t, get(einsteinID).AccountEnabled)
+	assert.False(t, get(marieID).AccountEnabled)
+
+	svc.applySchedule(serviceCtx(), now.Add(2*time.Hour))
+	einstein := get(einsteinID)
+	assert.False(t, einstein.AccountEnabled)
+	assert.NotNil(t, einstein.SignInSessionsValidFromDateTime)
+	marie := get(marieID)
+	assert.True(t, marie.AccountEnabled)
+	assert.Nil(t, marie.ScheduledEnableDateTime)
+}
+
+func TestExpiredAccount(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	past := timestamppb.New(time.Now().Add(-time.Minute))
+
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: einsteinID, ExpirationDateTime: past},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"ExpirationDateTime"}},
+	}, &proto.Account{}))
+
+	// expired accounts are refused before the scheduler disabled them
+	assertCode(t, 401, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{Query: "login eq 'einstein' and password eq 'relativity'"}, &proto.ListAccountsResponse{}))
+	session := &proto.ValidateSessionResponse{}
+	require.NoError(t, svc.ValidateSession(serviceCtx(), &proto.ValidateSessionRequest{AccountId: einsteinID, IssuedDateTime: timestamppb.Now()}, session))
+	assert.False(t, session.Valid)
+	assert.Equal(t, "expired", session.Reason)
+
+	// expired accounts are not enabled again until the expiration is extended
+	svc.applySchedule(serviceCtx(), time.Now())
+	assertCode(t, 400, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: einsteinID, AccountEnabled: true},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+	}, &proto.Account{}))
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: einsteinID, AccountEnabled: true},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled", "ExpirationDateTime"}},
+	}, &proto.Account{}))
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// revokeSessions invalidates the sessions and refresh tokens of the account issued before the given time. Tokens carry
+// their issue time in seconds, so the time is truncated to let tokens issued right after the revocation pass.
+func revokeSessions(a *proto.Account, ts *timestamppb.Timestamp) {
+	validFrom := &timestamppb.Timestamp{Seconds: ts.GetSeconds()}
+	a.SignInSessionsValidFromDateTime = validFrom
+	a.RefreshTokensValidFromDateTime = validFrom
+}
+
+// RevokeSessions implements the AccountsServiceHandler interface
+func (s Service) RevokeSessions(ctx context.Context, in *proto.RevokeSessionsRequest, out *proto.Account) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.RevokeSessions", trace.StringAttribute("account_id", in.Id))
+	defer func() { endSpan(span, err) }()
+
+	var acl *access
+	if acl, err = s.authorize(ctx, "RevokeSessions", func(a *access) bool { return a.mayManageAccounts() || a.hasAccount() }); err != nil {
+		return
+	}
+	// users sign themselves out everywhere without knowing their id
+	if in.Id == "" {
+		in.Id = acl.accountID
+	}
+
+	if s.ldap != nil {
+		return s.errReadOnly()
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	var id string
+	if id, err = cleanupID(in.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	if err = s.loadAccount(ctx, id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+	if !acl.managesAccount(out) && !acl.isSelf(out) {
+		return s.forbidden("RevokeSessions")
+	}
+	if out.DeletedDateTime != nil {
+		return merrors.BadRequest(s.id, "account %s is deleted", id)
+	}
+
+	now := timestamppb.Now()
+	revokeSessions(out, now)
+	out.LastModifiedDateTime = now
+
+	if err = s.writeAccount(ctx, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not persist revoked sessions")
+		return
+	}
+	if err = s.indexAccount(ctx, id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	s.audit(ctx, "revoke_sessions", "account", id, "", nil)
+
+	s.expandMemberOf(ctx, out)
+	hideSecrets(out)
+	return nil
+}
+
+// ValidateSession implements the AccountsServiceHandler interface. Tokens of unknown, disabled and deleted accounts
+// are invalid, as are tokens issued before the sessions of the account have been revoked.
+func (s Service) ValidateSession(ctx context.Context, in *proto.ValidateSessionRequest, out *proto.ValidateSessionResponse) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.ValidateSession", trace.StringAttribute("account_id", in.AccountId))
+	defer func() { endSpan(span, err) }()
+
+	if _, err = s.authorize(ctx, "ValidateSession", (*access).mayReadAccounts); err != nil {
+		return
+	}
+	if in.AccountId == "" {
+		return merrors.BadRequest(s.id, "account id missing")
+	}
+	if in.IssuedDateTime == nil {
+		return merrors.BadRequest(s.id, "issued date time missing")
+	}
+
+	a := &proto.Account{}
+	if s.ldap != nil {
+		err = s.getLDAPAccount(&proto.GetAccountRequest{Id: in.AccountId}, a)
+	} else {
+		var id string
+		if id, err = cleanupID(in.AccountId); err != nil {
+			return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+		}
+		accLock.Lock()
+		err = s.loadAccount(ctx, id, a)
+		accLock.Unlock()
+	}
+	if err != nil {
+		if merrors.FromError(err).GetCode() != 404 {
+			return err
+		}
+		out.Reason = "unknown_account"
+		return nil
+	}
+
+	validFrom := a.SignInSessionsValidFromDateTime
+	if in.RefreshToken {
+		validFrom = a.RefreshTokensValidFromDateTime
+	}
+	switch {
+	case a.DeletedDateTime != nil:
+		out.Reason = "deleted"
+	case !a.AccountEnabled:
+		out.Reason = "disabled"
+	case expired(a.ExpirationDateTime, time.Now()):
+		out.Reason = "expired"
+	case validFrom != nil && in.IssuedDateTime.GetSeconds() < validFrom.GetSeconds():
+		out.Reason = "revoked"
+	default:
+		out.Valid = true
+	}
+	span.AddAttributes(trace.BoolAttribute("valid", out.Valid))
+	return nil
+}
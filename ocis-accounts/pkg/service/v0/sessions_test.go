@@ -0,0 +1,85 @@
+package service
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSessions(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	hourAgo := timestamppb.New(time.Now().Add(-time.Hour))
+	validate := func(accountID string, issued *timestamppb.Timestamp, refreshToken bool) *proto.ValidateSessionResponse {
+		out := &proto.ValidateSessionResponse{}
+		require.NoError(t, svc.ValidateSession(serviceCtx(), &proto.ValidateSessionRequest{AccountId: accountID, IssuedDateTime: issued, RefreshToken: refreshToken}, out))
+		return out
+	}
+
+	assert.True(t, validate(einsteinID, hourAgo, false).Valid)
+	assert.Equal(t, "unknown_account", validate("00000000-0000-0000-0000-000000000000", hourAgo, false).Reason)
+
+	// users revoke their own sessions, but not those of others
+	userCtx := buildTestCtx(t, []string{ssvc.BundleUUIDRoleUser})
+	assertForbidden(t, svc.RevokeSessions(userCtx, &proto.RevokeSessionsRequest{Id: marieID}, &proto.Account{}))
+	einstein := &proto.Account{}
+	require.NoError(t, svc.RevokeSessions(userCtx, &proto.RevokeSessionsRequest{}, einstein))
+	assert.Equal(t, einsteinID, einstein.Id)
+	assert.NotNil(t, einstein.SignInSessionsValidFromDateTime)
+	assert.Equal(t, "revoked", validate(einsteinID, hourAgo, false).Reason)
+	assert.Equal(t, "revoked", validate(einsteinID, hourAgo, true).Reason)
+	assert.True(t, validate(einsteinID, timestamppb.Now(), false).Valid)
+	assert.True(t, validate(marieID, hourAgo, false).Valid)
+
+	// changing the password revokes the sessions
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: marieID, PasswordProfile: &proto.PasswordProfile{Password: "polonium"}},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"PasswordProfile.Password"}},
+	}, &proto.Account{}))
+	assert.Equal(t, "revoked", validate(marieID, hourAgo, false).Reason)
+
+	// disabled accounts have no valid sessions, not even after they are enabled again
+	update := func(enabled bool) {
+		require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+			Account:    &proto.Account{Id: richardID, AccountEnabled: enabled},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+		}, &proto.Account{}))
+	}
+	update(false)
+	assert.Equal(t, "disabled", validate(richardID, timestamppb.Now(), false).Reason)
+	update(true)
+	assert.Equal(t, "revoked", validate(richardID, hourAgo, false).Reason)
+	assert.True(t, validate(richardID, timestamppb.Now(), false).Valid)
+}
+
+func TestDisabledAccountSignIn(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	setEnabled := func(enabled bool) {
+		require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+			Account:    &proto.Account{Id: einsteinID, AccountEnabled: enabled},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+		}, &proto.Account{}))
+	}
+
+	setEnabled(false)
+	// disabled accounts are refused with the same error as a wrong password
+	err := signIn(svc, "einstein", "relativity")
+	assertCode(t, 401, err)
+	assert.Equal(t, merrors.FromError(signIn(svc, "einstein", "wrong")).Detail, merrors.FromError(err).Detail)
+	a := &proto.Account{}
+	require.NoError(t, svc.GetAccount(serviceCtx(), &proto.GetAccountRequest{Id: einsteinID}, a))
+	assert.Nil(t, a.LastSignInDateTime)
+
+	setEnabled(true)
+	assert.NoError(t, signIn(svc, "einstein", "relativity"))
+}
@@ -7,6 +7,7 @@ import (
 	olog "github.com/owncloud/ocis-pkg/v2/log"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	ssvc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"go.opencensus.io/trace"
 )
 
 const (
@@ -14,7 +15,8 @@ const (
 )
 
 // RegisterSettingsBundles pushes the settings bundle definitions for this extension to the ocis-settings service.
-func RegisterSettingsBundles(l *olog.Logger) {
+// It returns the last error, failed requests can be repeated.
+func RegisterSettingsBundles(l *olog.Logger) (err error) {
 	// TODO this won't work with a registry other than mdns. Look into Micro's client initialization.
 	// https://github.com/owncloud/ocis-proxy/issues/38
 	service := settings.NewBundleService("com.owncloud.api.settings", mclient.DefaultClient)
@@ -24,8 +26,11 @@ func RegisterSettingsBundles(l *olog.Logger) {
 	}
 
 	for i := range bundleRequests {
-		res, err := service.SaveBundle(context.Background(), &bundleRequests[i])
-		if err != nil {
+		ctx, span := startSettingsSpan(context.Background(), "SaveBundle", trace.StringAttribute("bundle_id", bundleRequests[i].Bundle.Id))
+		res, e := service.SaveBundle(ctx, &bundleRequests[i])
+		endSpan(span, e)
+		if e != nil {
+			err = e
 			l.Err(err).Str("bundle", bundleRequests[i].Bundle.Id).Msg("Error registering bundle")
 		} else {
 			l.Info().Str("bundle", res.Bundle.Id).Msg("Successfully registered bundle")
@@ -34,14 +39,18 @@ func RegisterSettingsBundles(l *olog.Logger) {
 
 	permissionRequests := generateProfilePermissionsRequests()
 	for i := range permissionRequests {
-		res, err := service.AddSettingToBundle(context.Background(), &permissionRequests[i])
+		ctx, span := startSettingsSpan(context.Background(), "AddSettingToBundle", trace.StringAttribute("setting_id", permissionRequests[i].Setting.Id))
+		res, e := service.AddSettingToBundle(ctx, &permissionRequests[i])
+		endSpan(span, e)
 		bundleID := permissionRequests[i].BundleId
-		if err != nil {
+		if e != nil {
+			err = e
 			l.Err(err).Str("bundle", bundleID).Str("setting", permissionRequests[i].Setting.Id).Msg("Error adding setting to bundle")
 		} else {
 			l.Info().Str("bundle", bundleID).Str("setting", res.Setting.Id).Msg("Successfully added setting to bundle")
 		}
 	}
+	return
 }
 
 var languageSetting = settings.Setting_SingleChoiceValue{
@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	settings_svc "github.com/owncloud/ocis-settings/pkg/service/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/provider"
+	"go.opencensus.io/trace"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SyncAccounts implements the AccountsServiceHandler interface
+func (s Service) SyncAccounts(ctx context.Context, in *proto.SyncAccountsRequest, out *proto.SyncAccountsResponse) (err error) {
+	ctx, span := startSpan(ctx, "AccountsService.SyncAccounts", trace.BoolAttribute("dry_run", in.DryRun))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.checkPermission(ctx, AccountManagementPermissionID, "SyncAccounts"); err != nil {
+		return
+	}
+	if s.Config.LDAP.Hostname == "" {
+		return merrors.BadRequest(s.id, "no ldap server configured")
+	}
+	if s.ldap != nil {
+		return merrors.BadRequest(s.id, "accounts are read from ldap directly, enable the ldap synchronisation to import them")
+	}
+
+	out.Changes, err = s.sync(ctx, in.DryRun)
+	return
+}
+
+// RunSync periodically imports accounts and groups from ldap. It blocks until ctx is done.
+func (s Service) RunSync(ctx context.Context) {
+	if s.Config.LDAP.Hostname == "" || !s.Config.LDAP.Sync {
+		return
+	}
+	if s.Config.LDAP.SyncInterval <= 0 {
+		s.log.Info().Msg("scheduled ldap synchronisation is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.LDAP.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changes, err := s.sync(ctx, false)
+			if err != nil {
+				s.log.Error().Err(err).Msg("could not synchronise ldap")
+				continue
+			}
+			for _, c := range changes {
+				if c.Action == "error" {
+					s.log.Error().Interface("change", c).Msg("could not synchronise object")
+				}
+			}
+			s.log.Info().Int("changes", len(changes)).Msg("synchronised ldap")
+		}
+	}
+}
+
+// sync imports the accounts and groups from ldap and returns the changes. Local records are matched by their
+// on_premises_immutable_id. When dryRun is set the changes are only calculated.
+func (s Service) sync(ctx context.Context, dryRun bool) ([]*proto.SyncChange, error) {
+	ctx, span := startSpan(ctx, "sync", trace.BoolAttribute("dry_run", dryRun))
+	defer span.End()
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	posixLock.Lock()
+	defer posixLock.Unlock()
+
+	l := provider.NewLDAP(s.Config.LDAP)
+	remoteGroups, err := l.ListGroups("")
+	if err != nil {
+		return nil, merrors.InternalServerError(s.id, "could not list ldap groups: %v", err.Error())
+	}
+	remoteAccounts, err := l.ListAccounts("")
+	if err != nil {
+		return nil, merrors.InternalServerError(s.id, "could not list ldap accounts: %v", err.Error())
+	}
+
+	localGroups, err := s.loadSyncedGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	localAccounts, err := s.loadSyncedAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	changes := []*proto.SyncChange{}
+	record := func(c *proto.SyncChange) {
+		if c != nil {
+			changes = append(changes, c)
+		}
+	}
+
+	// groups first, the memberships of the accounts reference them
+	groupIDs := map[string]string{}
+	for _, remote := range remoteGroups {
+		change, id := s.syncGroup(ctx, localGroups[remote.OnPremisesImmutableId], remote, now, dryRun)
+		record(change)
+		if id != "" {
+			groupIDs[remote.OnPremisesImmutableId] = id
+		}
+		delete(localGroups, remote.OnPremisesImmutableId)
+	}
+	// the remaining groups have vanished from the directory
+	syncedGroups := map[string]struct{}{}
+	for _, id := range groupIDs {
+		syncedGroups[id] = struct{}{}
+	}
+	for _, g := range localGroups {
+		syncedGroups[g.Id] = struct{}{}
+		if g.DeletedDateTime != nil {
+			continue
+		}
+		record(&proto.SyncChange{Kind: "group", Action: "delete", Id: g.Id, ImmutableId: g.OnPremisesImmutableId, Name: g.OnPremisesSamAccountName})
+		if !dryRun {
+			if err := s.DeleteGroup(s.asService(ctx), &proto.DeleteGroupRequest{Id: g.Id}, &empty.Empty{}); err != nil {
+				s.log.Error().Err(err).Str("id", g.Id).Msg("could not delete vanished group")
+			}
+		}
+	}
+
+	for _, remote := range remoteAccounts {
+		// map the directory groups to local groups
+		memberOf := []*proto.Group{}
+		for _, g := range remote.MemberOf {
+			if id, ok := groupIDs[g.OnPremisesImmutableId]; ok {
+				memberOf = append(memberOf, &proto.Group{Id: id})
+			}
+		}
+		record(s.syncAccount(ctx, localAccounts[remote.OnPremisesImmutableId], remote, memberOf, syncedGroups, now, dryRun))
+		delete(localAccounts, remote.OnPremisesImmutableId)
+	}
+	// the remaining accounts have vanished from the directory
+	for _, a := range localAccounts {
+		if a.DeletedDateTime != nil || !a.AccountEnabled {
+			continue
+		}
+		record(&proto.SyncChange{Kind: "account", Action: "disable", Id: a.Id, ImmutableId: a.OnPremisesImmutableId, Name: a.OnPremisesSamAccountName, Properties: []string{"account_enabled"}})
+		if !dryRun {
+			a.AccountEnabled = false
+			a.LastModifiedDateTime = now
+			revokeSessions(a, now)
+			a.OnPremisesLastSyncDateTime = now
+			if err := s.writeAccount(ctx, a); err != nil {
+				s.log.Error().Err(err).Str("id", a.Id).Msg("could not disable vanished account")
+			} else if err := s.indexAccount(ctx, a.Id); err != nil {
+				s.log.Error().Err(err).Str("id", a.Id).Msg("could not index vanished account")
+			}
+		}
+	}
+
+	if !dryRun {
+		for _, c := range changes {
+			// deletions are recorded by DeleteGroup
+			switch c.Action {
+			case "create", "update", "disable":
+				s.audit(ctx, "sync_"+c.Action, c.Kind, c.Id, "", c.Properties)
+			}
+		}
+		s.countDocuments(ctx, "account")
+		s.countDocuments(ctx, "group")
+	}
+
+	return changes, nil
+}
+
+// syncGroup creates or updates the local group from the directory group. It returns a nil change if nothing changed
+// and the id of the local group, which is empty if the group could not be created.
+func (s Service) syncGroup(ctx context.Context, local, remote *proto.Group, now *timestamppb.Timestamp, dryRun bool) (*proto.SyncChange, string) {
+	change := &proto.SyncChange{Kind: "group", ImmutableId: remote.OnPremisesImmutableId, Name: remote.OnPremisesSamAccountName}
+	lastSync := now.AsTime().Format(time.RFC3339)
+
+	if local == nil {
+		g := &proto.Group{
+			Id:                          uuid.Must(uuid.NewV4()).String(),
+			DisplayName:                 remote.DisplayName,
+			OnPremisesSamAccountName:    remote.OnPremisesSamAccountName,
+			GidNumber:                   remote.GidNumber,
+			CreatedDateTime:             now,
+			OnPremisesSyncEnabled:       true,
+			OnPremisesImmutableId:       remote.OnPremisesImmutableId,
+			OnPremisesDistinguishedName: remote.OnPremisesDistinguishedName,
+			OnPremisesLastSyncDateTime:  lastSync,
+		}
+		change.Action = "create"
+		if err := s.checkUniqueGroup(ctx, g); err != nil {
+			return syncError(change, err), ""
+		}
+		if err := s.assignGIDNumber(ctx, g); err != nil {
+			return syncError(change, err), ""
+		}
+		if dryRun {
+			return change, ""
+		}
+		if err := s.writeGroup(ctx, g); err != nil {
+			return syncError(change, err), ""
+		}
+		if err := s.indexGroup(ctx, g.Id); err != nil {
+			return syncError(change, err), g.Id
+		}
+		change.Id = g.Id
+		return change, g.Id
+	}
+
+	change.Id = local.Id
+	if local.DeletedDateTime != nil {
+		// deleted locally, it is not brought back
+		return nil, ""
+	}
+
+	updated := gproto.Clone(local).(*proto.Group)
+	updated.DisplayName = remote.DisplayName
+	updated.OnPremisesSamAccountName = remote.OnPremisesSamAccountName
+	updated.OnPremisesDistinguishedName = remote.OnPremisesDistinguishedName
+	if remote.GidNumber != 0 {
+		updated.GidNumber = remote.GidNumber
+	}
+	change.Properties = changedGroupProperties(local, updated)
+
+	var err error
+	if updated.OnPremisesSamAccountName != local.OnPremisesSamAccountName {
+		err = s.checkUniqueGroup(ctx, updated)
+	}
+	if err == nil && updated.GidNumber != local.GidNumber {
+		err = s.checkNumber(ctx, "group", "gid_number", updated.GidNumber, local.Id)
+	}
+
+	if err != nil {
+		// keep the local values and remember why they could not be updated
+		syncError(change, err)
+		local.OnPremisesProvisioningErrors = []*proto.OnPremisesProvisioningError{provisioningError(err, now)}
+		updated = local
+	} else if len(change.Properties) > 0 {
+		change.Action = "update"
+		updated.OnPremisesProvisioningErrors = nil
+	}
+	if dryRun {
+		return changeOrNil(change), local.Id
+	}
+
+	updated.OnPremisesLastSyncDateTime = lastSync
+	if err := s.writeGroup(ctx, updated); err != nil {
+		return syncError(change, err), local.Id
+	}
+	if err := s.indexGroup(ctx, updated.Id); err != nil {
+		return syncError(change, err), local.Id
+	}
+	return changeOrNil(change), local.Id
+}
+
+// syncAccount creates or updates the local account from the directory account. It returns nil if nothing changed.
+// Only memberships in synced groups are managed, memberships in local groups are kept.
+func (s Service) syncAccount(ctx context.Context, local, remote *proto.Account, memberOf []*proto.Group, syncedGroups map[string]struct{}, now *timestamppb.Timestamp, dryRun bool) *proto.SyncChange {
+	change := &proto.SyncChange{Kind: "account", ImmutableId: remote.OnPremisesImmutableId, Name: remote.OnPremisesSamAccountName}
+
+	if local == nil {
+		a := &proto.Account{
+			Id:                          uuid.Must(uuid.NewV4()).String(),
+			AccountEnabled:              true,
+			DisplayName:                 remote.DisplayName,
+			PreferredName:               remote.PreferredName,
+			OnPremisesSamAccountName:    remote.OnPremisesSamAccountName,
+			Mail:                        remote.Mail,
+			UidNumber:                   remote.UidNumber,
+			GidNumber:                   remote.GidNumber,
+			CreatedDateTime:             now,
+			LastModifiedDateTime:        now,
+			OnPremisesSyncEnabled:       true,
+			OnPremisesImmutableId:       remote.OnPremisesImmutableId,
+			OnPremisesDistinguishedName: remote.OnPremisesDistinguishedName,
+			OnPremisesLastSyncDateTime:  now,
+		}
+		change.Action = "create"
+		if err := s.checkUniqueAccount(ctx, a, nil); err != nil {
+			return syncError(change, err)
+		}
+		if err := s.assignUIDNumber(ctx, a); err != nil {
+			return syncError(change, err)
+		}
+		if dryRun {
+			return change
+		}
+		if err := s.writeAccount(ctx, a); err != nil {
+			return syncError(change, err)
+		}
+		if s.RoleService != nil {
+			assignRoleToUser(ctx, a.Id, settings_svc.BundleUUIDRoleUser, s.RoleService, s.log)
+		}
+		if err := s.updateMemberOf(ctx, a, memberOf); err != nil {
+			return syncError(change, err)
+		}
+		if err := s.indexAccount(ctx, a.Id); err != nil {
+			return syncError(change, err)
+		}
+		change.Id = a.Id
+		return change
+	}
+
+	change.Id = local.Id
+	if local.DeletedDateTime != nil {
+		// deleted locally, it is not brought back
+		return nil
+	}
+
+	prev := gproto.Clone(local).(*proto.Account)
+	local.DisplayName = remote.DisplayName
+	local.PreferredName = remote.PreferredName
+	local.OnPremisesSamAccountName = remote.OnPremisesSamAccountName
+	local.Mail = remote.Mail
+	local.OnPremisesDistinguishedName = remote.OnPremisesDistinguishedName
+	if remote.UidNumber != 0 {
+		local.UidNumber = remote.UidNumber
+	}
+	if remote.GidNumber != 0 {
+		local.GidNumber = remote.GidNumber
+	}
+	change.Properties = changedAccountProperties(prev, local)
+
+	// memberships in local groups are kept
+	for i := range prev.MemberOf {
+		if _, ok := syncedGroups[prev.MemberOf[i].Id]; !ok {
+			memberOf = append(memberOf, &proto.Group{Id: prev.MemberOf[i].Id})
+		}
+	}
+	if !sameGroups(prev.MemberOf, memberOf) {
+		change.Properties = append(change.Properties, "member_of")
+	}
+
+	err := s.checkUniqueAccount(ctx, local, prev)
+	if err == nil && local.UidNumber != prev.UidNumber {
+		err = s.checkNumber(ctx, "account", "uid_number", local.UidNumber, local.Id)
+	}
+
+	if err != nil {
+		// keep the local values and remember why they could not be updated
+		syncError(change, err)
+		prev.OnPremisesProvisioningErrors = []*proto.OnPremisesProvisioningError{provisioningError(err, now)}
+		local = prev
+	} else if len(change.Properties) > 0 {
+		change.Action = "update"
+		local.LastModifiedDateTime = now
+		local.OnPremisesProvisioningErrors = nil
+	}
+	if dryRun {
+		return changeOrNil(change)
+	}
+
+	local.OnPremisesLastSyncDateTime = now
+	if err := s.writeAccount(ctx, local); err != nil {
+		return syncError(change, err)
+	}
+	if change.Action == "update" {
+		if err := s.updateMemberOf(ctx, local, memberOf); err != nil {
+			return syncError(change, err)
+		}
+	}
+	if err := s.indexAccount(ctx, local.Id); err != nil {
+		return syncError(change, err)
+	}
+	return changeOrNil(change)
+}
+
+// loadSyncedAccounts returns all local accounts imported from ldap by their on_premises_immutable_id
+func (s Service) loadSyncedAccounts(ctx context.Context) (map[string]*proto.Account, error) {
+	dir := filepath.Join(s.Config.Server.AccountsDataPath, "accounts")
+	list, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, merrors.InternalServerError(s.id, "could not list accounts folder: %v", err.Error())
+	}
+
+	accounts := map[string]*proto.Account{}
+	for _, file := range list {
+		a := &proto.Account{}
+		if err := s.loadAccount(ctx, file.Name(), a); err != nil {
+			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not load account, skipping")
+			continue
+		}
+		if a.OnPremisesSyncEnabled && a.OnPremisesImmutableId != "" {
+			accounts[a.OnPremisesImmutableId] = a
+		}
+	}
+	return accounts, nil
+}
+
+// loadSyncedGroups returns all local groups imported from ldap by their on_premises_immutable_id
+func (s Service) loadSyncedGroups(ctx context.Context) (map[string]*proto.Group, error) {
+	dir := filepath.Join(s.Config.Server.AccountsDataPath, "groups")
+	list, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, merrors.InternalServerError(s.id, "could not list groups folder: %v", err.Error())
+	}
+
+	groups := map[string]*proto.Group{}
+	for _, file := range list {
+		g := &proto.Group{}
+		if err := s.loadGroup(ctx, file.Name(), g); err != nil {
+			s.log.Error().Err(err).Str("file", file.Name()).Msg("could not load group, skipping")
+			continue
+		}
+		if g.OnPremisesSyncEnabled && g.OnPremisesImmutableId != "" {
+			groups[g.OnPremisesImmutableId] = g
+		}
+	}
+	return groups, nil
+}
+
+func changedAccountProperties(prev, a *proto.Account) []string {
+	changed := []string{}
+	for _, p := range []struct {
+		name     string
+		old, new interface{}
+	}{
+		{"display_name", prev.DisplayName, a.DisplayName},
+		{"preferred_name", prev.PreferredName, a.PreferredName},
+		{"on_premises_sam_account_name", prev.OnPremisesSamAccountName, a.OnPremisesSamAccountName},
+		{"mail", prev.Mail, a.Mail},
+		{"uid_number", prev.UidNumber, a.UidNumber},
+		{"gid_number", prev.GidNumber, a.GidNumber},
+		{"on_premises_distinguished_name", prev.OnPremisesDistinguishedName, a.OnPremisesDistinguishedName},
+	} {
+		if p.old != p.new {
+			changed = append(changed, p.name)
+		}
+	}
+	return changed
+}
+
+func changedGroupProperties(prev, g *proto.Group) []string {
+	changed := []string{}
+	for _, p := range []struct {
+		name     string
+		old, new interface{}
+	}{
+		{"display_name", prev.DisplayName, g.DisplayName},
+		{"on_premises_sam_account_name", prev.OnPremisesSamAccountName, g.OnPremisesSamAccountName},
+		{"gid_number", prev.GidNumber, g.GidNumber},
+		{"on_premises_distinguished_name", prev.OnPremisesDistinguishedName, g.OnPremisesDistinguishedName},
+	} {
+		if p.old != p.new {
+			changed = append(changed, p.name)
+		}
+	}
+	return changed
+}
+
+// sameGroups checks if both lists reference the same group ids
+func sameGroups(a, b []*proto.Group) bool {
+	ids := map[string]struct{}{}
+	for i := range a {
+		ids[a[i].Id] = struct{}{}
+	}
+	other := map[string]struct{}{}
+	for i := range b {
+		if _, ok := ids[b[i].Id]; !ok {
+			return false
+		}
+		other[b[i].Id] = struct{}{}
+	}
+	return len(ids) == len(other)
+}
+
+// syncError marks the change as failed
+func syncError(c *proto.SyncChange, err error) *proto.SyncChange {
+	c.Action = "error"
+	c.Error = merrors.Parse(err.Error()).Detail
+	return c
+}
+
+// provisioningError records a failed synchronisation on the local object
+func provisioningError(err error, now *timestamppb.Timestamp) *proto.OnPremisesProvisioningError {
+	e := merrors.Parse(err.Error())
+	category := "SyncError"
+	if e.Code == 409 {
+		category = "PropertyConflict"
+	}
+	return &proto.OnPremisesProvisioningError{
+		Category:         category,
+		OccurredDateTime: now,
+		Value:            e.Detail,
+	}
+}
+
+// changeOrNil drops changes without an action
+func changeOrNil(c *proto.SyncChange) *proto.SyncChange {
+	if c.Action == "" {
+		return nil
+	}
+	return c
+}
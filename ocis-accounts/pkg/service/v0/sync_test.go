@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	ldapserver "github.com/nmcclain/ldap"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const syncDataPath = "/var/tmp/ocis-accounts-sync-tests"
+
+// syncDirectory is a minimal in-process directory, filters are applied by the server
+type syncDirectory struct {
+	sync.Mutex
+	entries map[string]map[string][]string
+}
+
+func (d *syncDirectory) Bind(bindDN, bindSimplePw string, conn net.Conn) (ldapserver.LDAPResultCode, error) {
+	return ldapserver.LDAPResultSuccess, nil
+}
+
+func (d *syncDirectory) Search(boundDN string, req ldapserver.SearchRequest, conn net.Conn) (ldapserver.ServerSearchResult, error) {
+	d.Lock()
+	defer d.Unlock()
+	entries := []*ldapserver.Entry{}
+	for dn, attributes := range d.entries {
+		if !strings.HasSuffix(dn, req.BaseDN) {
+			continue
+		}
+		e := &ldapserver.Entry{DN: dn}
+		for name, values := range attributes {
+			e.Attributes = append(e.Attributes, &ldapserver.EntryAttribute{Name: name, Values: values})
+		}
+		entries = append(entries, e)
+	}
+	return ldapserver.ServerSearchResult{Entries: entries, ResultCode: ldapserver.LDAPResultSuccess}, nil
+}
+
+func (d *syncDirectory) set(dn string, attributes map[string][]string) {
+	d.Lock()
+	defer d.Unlock()
+	if attributes == nil {
+		delete(d.entries, dn)
+		return
+	}
+	d.entries[dn] = attributes
+}
+
+func TestSyncAccounts(t *testing.T) {
+	d := &syncDirectory{entries: map[string]map[string][]string{
+		"cn=staff,ou=groups,dc=example,dc=org": {
+			"objectClass": {"posixGroup"},
+			"entryUUID":   {"b9a1f1a0-8a3e-4a43-9a5e-0c1d2e3f4a5b"},
+			"cn":          {"staff"},
+		},
+		"uid=alice,ou=users,dc=example,dc=org": {
+			"objectClass": {"posixAccount"},
+			"entryUUID":   {"0d5c7a6e-3f7b-4d8e-9c1a-2b3c4d5e6f70"},
+			"uid":         {"alice"},
+			"displayName": {"Alice Hansen"},
+			"mail":        {"alice@example.org"},
+			"memberOf":    {"cn=staff,ou=groups,dc=example,dc=org"},
+		},
+		"uid=bob,ou=users,dc=example,dc=org": {
+			"objectClass": {"posixAccount"},
+			"entryUUID":   {"7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b"},
+			"uid":         {"bob"},
+			"displayName": {"Bob Builder"},
+			"mail":        {"bob@example.org"},
+		},
+	}}
+
+	server := ldapserver.NewServer()
+	server.EnforceLDAP = true
+	server.BindFunc("", d)
+	server.SearchFunc("", d)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go server.Serve(ln)
+
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = syncDataPath
+	cfg.Bootstrap.DemoUsers = true
+	cfg.Posix = config.Posix{UID: config.Bound{Lower: 20000, Upper: 29999}, GID: config.Bound{Lower: 30000, Upper: 39999}, DefaultGID: 30000}
+	cfg.LDAP = config.LDAP{
+		Hostname:    "127.0.0.1",
+		Port:        ln.Addr().(*net.TCPAddr).Port,
+		BaseDN:      "dc=example,dc=org",
+		UserFilter:  "(objectClass=posixAccount)",
+		GroupFilter: "(objectClass=posixGroup)",
+		Sync:        true,
+		Schema: config.LDAPSchema{
+			AccountID:   "entryUUID",
+			Username:    "uid",
+			DisplayName: "displayName",
+			Mail:        "mail",
+			Groups:      "memberOf",
+			UIDNumber:   "uidNumber",
+			GIDNumber:   "gidNumber",
+			GroupID:     "entryUUID",
+			GroupName:   "cn",
+		},
+	}
+	defer os.RemoveAll(syncDataPath)
+	svc, err := New(Logger(olog.NewLogger()), Config(cfg), RoleService(buildRoleServiceMock()))
+	require.NoError(t, err)
+
+	// a dry run only reports the changes
+	out := &proto.SyncAccountsResponse{}
+	require.NoError(t, svc.SyncAccounts(serviceCtx(), &proto.SyncAccountsRequest{DryRun: true}, out))
+	assert.Len(t, out.Changes, 3)
+	for _, c := range out.Changes {
+		assert.Equal(t, "create", c.Action)
+	}
+	accounts, err := svc.loadSyncedAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, accounts, 0)
+
+	out = &proto.SyncAccountsResponse{}
+	require.NoError(t, svc.SyncAccounts(serviceCtx(), &proto.SyncAccountsRequest{}, out))
+	assert.Len(t, out.Changes, 3)
+	accounts, err = svc.loadSyncedAccounts(context.Background())
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	alice := accounts["0d5c7a6e-3f7b-4d8e-9c1a-2b3c4d5e6f70"]
+	assert.True(t, alice.AccountEnabled)
+	assert.Equal(t, "alice", alice.OnPremisesSamAccountName)
+	assert.NotZero(t, alice.UidNumber)
+	assert.NotNil(t, alice.OnPremisesLastSyncDateTime)
+	require.Len(t, alice.MemberOf, 1)
+
+	// nothing changed
+	out = &proto.SyncAccountsResponse{}
+	require.NoError(t, svc.SyncAccounts(serviceCtx(), &proto.SyncAccountsRequest{}, out))
+	assert.Len(t, out.Changes, 0)
+
+	// changed attributes are updated, vanished accounts are disabled
+	d.set("uid=alice,ou=users,dc=example,dc=org", map[string][]string{
+		"objectClass": {"posixAccount"},
+		"entryUUID":   {"0d5c7a6e-3f7b-4d8e-9c1a-2b3c4d5e6f70"},
+		"uid":         {"alice"},
+		"displayName": {"Alice Liddell"},
+		"mail":        {"alice@example.org"},
+	})
+	d.set("uid=bob,ou=users,dc=example,dc=org", nil)
+
+	out = &proto.SyncAccountsResponse{}
+	require.NoError(t, svc.SyncAccounts(serviceCtx(), &proto.SyncAccountsRequest{}, out))
+	require.Len(t, out.Changes, 2)
+	accounts, err = svc.loadSyncedAccounts(context.Background())
+	require.NoError(t, err)
+	alice = accounts["0d5c7a6e-3f7b-4d8e-9c1a-2b3c4d5e6f70"]
+	assert.Equal(t, "Alice Liddell", alice.DisplayName)
+	assert.Len(t, alice.MemberOf, 0)
+	assert.False(t, accounts["7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b"].AccountEnabled)
+
+	// conflicts are recorded on the account
+	d.set("uid=alice,ou=users,dc=example,dc=org", map[string][]string{
+		"objectClass": {"posixAccount"},
+		"entryUUID":   {"0d5c7a6e-3f7b-4d8e-9c1a-2b3c4d5e6f70"},
+		"uid":         {"alice"},
+		"displayName": {"Alice Liddell"},
+		"mail":        {"einstein@example.org"},
+	})
+	out = &proto.SyncAccountsResponse{}
+	require.NoError(t, svc.SyncAccounts(serviceCtx(), &proto.SyncAccountsRequest{}, out))
+	require.Len(t, out.Changes, 1)
+	assert.Equal(t, "error", out.Changes[0].Action)
+	accounts, err = svc.loadSyncedAccounts(context.Background())
+	require.NoError(t, err)
+	alice = accounts["0d5c7a6e-3f7b-4d8e-9c1a-2b3c4d5e6f70"]
+	assert.Equal(t, "alice@example.org", alice.Mail)
+	require.Len(t, alice.OnPremisesProvisioningErrors, 1)
+	assert.Equal(t, "PropertyConflict", alice.OnPremisesProvisioningErrors[0].Category)
+
+}
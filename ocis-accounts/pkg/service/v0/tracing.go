@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/blevesearch/bleve"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"go.opencensus.io/plugin/ochttp"
+	"go.opencensus.io/trace"
+	"go.opencensus.io/trace/propagation"
+)
+
+// TraceContextMetadataKey is the metadata key carrying the binary span context, base64 encoded without padding. It is
+// the key used by the opencensus wrappers of go-micro, so traces continue across go-micro services.
+const TraceContextMetadataKey = "X-Trace-Context"
+
+// startSpan starts a child span of the span in ctx. The exporters are configured by the ocis binary, without them
+// spans are only sampled and dropped.
+func startSpan(ctx context.Context, name string, attributes ...trace.Attribute) (context.Context, *trace.Span) {
+	ctx, span := trace.StartSpan(ctx, "accounts."+name)
+	span.AddAttributes(attributes...)
+	return ctx, span
+}
+
+// endSpan records the status of the error and ends the span
+func endSpan(span *trace.Span, err error) {
+	if err != nil {
+		e := merrors.Parse(err.Error())
+		code := int(e.Code)
+		if code == 0 {
+			code = http.StatusInternalServerError
+		}
+		span.SetStatus(ochttp.TraceStatus(code, e.Detail))
+	}
+	span.End()
+}
+
+// startSettingsSpan starts a client span for a call to the settings service and adds its span context to the metadata
+// of the returned context
+func startSettingsSpan(ctx context.Context, method string, attributes ...trace.Attribute) (context.Context, *trace.Span) {
+	ctx, span := trace.StartSpan(ctx, "settings."+method, trace.WithSpanKind(trace.SpanKindClient))
+	span.AddAttributes(attributes...)
+	traceContext := base64.RawStdEncoding.EncodeToString(propagation.Binary(span.SpanContext()))
+	return metadata.Set(ctx, TraceContextMetadataKey, traceContext), span
+}
+
+// TraceContextFromMetadata returns the span context of the caller from the metadata of an incoming request
+func TraceContextFromMetadata(ctx context.Context) (trace.SpanContext, bool) {
+	encoded, ok := metadata.Get(ctx, TraceContextMetadataKey)
+	if !ok {
+		return trace.SpanContext{}, false
+	}
+	b, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	return propagation.FromBinary(b)
+}
+
+// search runs a search request against the index in a span of its own
+func (s Service) search(ctx context.Context, req *bleve.SearchRequest) (res *bleve.SearchResult, err error) {
+	_, span := startSpan(ctx, "index.search")
+	defer func() { endSpan(span, err) }()
+	if q, err := json.Marshal(req.Query); err == nil {
+		span.AddAttributes(trace.StringAttribute("query", string(q)))
+	}
+	res, err = s.index.Search(req)
+	if err == nil {
+		span.AddAttributes(trace.Int64Attribute("hits", int64(res.Total)))
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/micro/go-micro/v2/metadata"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/config"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+	"go.opencensus.io/trace/propagation"
+)
+
+const tracingDataPath = "/var/tmp/ocis-accounts-tracing-tests"
+
+// spanRecorder collects the exported spans
+type spanRecorder struct {
+	sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(s *trace.SpanData) {
+	r.Lock()
+	defer r.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+func (r *spanRecorder) byName(name string) []*trace.SpanData {
+	r.Lock()
+	defer r.Unlock()
+	spans := []*trace.SpanData{}
+	for _, s := range r.spans {
+		if s.Name == name {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+func TestTracing(t *testing.T) {
+	defer os.RemoveAll(tracingDataPath)
+
+	cfg := config.New()
+	cfg.Server.Name = "accounts"
+	cfg.Server.AccountsDataPath = tracingDataPath
+	cfg.Bootstrap.DemoUsers = true
+	svc, err := New(Logger(olog.NewLogger()), Config(cfg), RoleService(buildRoleServiceMock()))
+	require.NoError(t, err)
+
+	r := &spanRecorder{}
+	trace.RegisterExporter(r)
+	defer trace.UnregisterExporter(r)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	defer trace.ApplyConfig(trace.Config{DefaultSampler: trace.ProbabilitySampler(1e-4)})
+
+	ctx, parent := trace.StartSpan(serviceCtx(), "test")
+	out := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{Query: "login eq 'einstein' and password eq 'relativity'"}, out))
+	parent.End()
+	require.Len(t, out.Accounts, 1)
+
+	rpc := r.byName("accounts.AccountsService.ListAccounts")
+	require.Len(t, rpc, 1)
+	assert.Equal(t, parent.SpanContext().TraceID, rpc[0].TraceID)
+	assert.Equal(t, parent.SpanContext().SpanID, rpc[0].ParentSpanID)
+	assert.Equal(t, "on_premises_sam_account_name eq 'einstein'", rpc[0].Attributes["query"])
+
+	search := r.byName("accounts.index.search")
+	require.Len(t, search, 1)
+	assert.Equal(t, rpc[0].SpanID, search[0].ParentSpanID)
+	assert.NotContains(t, search[0].Attributes["query"], "relativity")
+
+	accountIDs := []interface{}{}
+	for _, s := range r.byName("accounts.storage.read") {
+		if id, ok := s.Attributes["account_id"]; ok {
+			accountIDs = append(accountIDs, id)
+		}
+	}
+	assert.Contains(t, accountIDs, "4c510ada-c86b-4815-8820-42cdf82c3d51")
+	assert.Len(t, r.byName("accounts.expandMemberOf"), 1)
+
+	// failed requests record their status
+	require.Error(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "unknown"}, &proto.Account{}))
+	get := r.byName("accounts.AccountsService.GetAccount")
+	require.Len(t, get, 1)
+	assert.Equal(t, "unknown", get[0].Attributes["account_id"])
+	assert.Equal(t, int32(trace.StatusCodeNotFound), get[0].Status.Code)
+}
+
+func TestTraceContextFromMetadata(t *testing.T) {
+	_, ok := TraceContextFromMetadata(context.Background())
+	assert.False(t, ok)
+
+	sc := trace.SpanContext{
+		TraceID:      trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:       trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceOptions: 1,
+	}
+	ctx := metadata.Set(context.Background(), TraceContextMetadataKey, base64.RawStdEncoding.EncodeToString(propagation.Binary(sc)))
+	got, ok := TraceContextFromMetadata(ctx)
+	require.True(t, ok)
+	assert.Equal(t, sc, got)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	bquery "github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+)
+
+// uniqueAccountProperties lists the account properties that must not be shared by two accounts
+var uniqueAccountProperties = []struct {
+	field string
+	value func(a *proto.Account) string
+}{
+	{"preferred_name", func(a *proto.Account) string { return a.PreferredName }},
+	{"on_premises_sam_account_name", func(a *proto.Account) string { return a.OnPremisesSamAccountName }},
+	{"mail", func(a *proto.Account) string { return a.Mail }},
+}
+
+// checkUniqueAccount returns a conflict error if another account already uses one of the unique properties or
+// identities.
+// Only properties that differ from the previous version of the account are checked, prev is nil for new accounts.
+// Deleted accounts keep their properties, they might be restored.
+func (s Service) checkUniqueAccount(ctx context.Context, a *proto.Account, prev *proto.Account) error {
+	for _, p := range uniqueAccountProperties {
+		value := p.value(a)
+		if value == "" || (prev != nil && strings.EqualFold(p.value(prev), value)) {
+			continue
+		}
+		ids, err := s.findCandidates(ctx, "account", p.field, value)
+		if err != nil {
+			return merrors.InternalServerError(s.id, "could not check %s: %v", p.field, err.Error())
+		}
+		for _, id := range ids {
+			if id == a.Id {
+				continue
+			}
+			other := &proto.Account{}
+			if err := s.loadAccount(ctx, id, other); err != nil {
+				s.log.Error().Err(err).Str("id", id).Msg("could not load account, skipping")
+				continue
+			}
+			if strings.EqualFold(p.value(other), value) {
+				return merrors.Conflict(s.id, "%s '%s' is already used by account %s", p.field, value, id)
+			}
+		}
+	}
+	return s.checkUniqueIdentities(ctx, a, prev)
+}
+
+// checkUniqueGroup returns a conflict error if another group already uses the on_premises_sam_account_name
+func (s Service) checkUniqueGroup(ctx context.Context, g *proto.Group) error {
+	if g.OnPremisesSamAccountName == "" {
+		return nil
+	}
+	ids, err := s.findCandidates(ctx, "group", "on_premises_sam_account_name", g.OnPremisesSamAccountName)
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not check on_premises_sam_account_name: %v", err.Error())
+	}
+	for _, id := range ids {
+		if id == g.Id {
+			continue
+		}
+		other := &proto.Group{}
+		if err := s.loadGroup(ctx, id, other); err != nil {
+			s.log.Error().Err(err).Str("id", id).Msg("could not load group, skipping")
+			continue
+		}
+		if strings.EqualFold(other.OnPremisesSamAccountName, g.OnPremisesSamAccountName) {
+			return merrors.Conflict(s.id, "on_premises_sam_account_name '%s' is already used by group %s", g.OnPremisesSamAccountName, id)
+		}
+	}
+	return nil
+}
+
+// findCandidates returns the ids of all documents of the given type that contain all terms of the value in the field.
+// The field mapping is applied to the value, so callers have to compare the actual values of the candidates.
+func (s Service) findCandidates(ctx context.Context, bleveType, field, value string) ([]string, error) {
+	tq := bleve.NewTermQuery(bleveType)
+	tq.SetField("bleve_type")
+
+	mq := bleve.NewMatchQuery(value)
+	mq.SetField(field)
+	mq.SetOperator(bquery.MatchQueryOperatorAnd)
+
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(tq, mq))
+	searchRequest.Size = 100
+
+	ids := []string{}
+	for {
+		searchResult, err := s.search(ctx, searchRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range searchResult.Hits {
+			ids = append(ids, hit.ID)
+		}
+		if len(searchResult.Hits) < searchRequest.Size {
+			return ids, nil
+		}
+		searchRequest.From += searchRequest.Size
+	}
+}
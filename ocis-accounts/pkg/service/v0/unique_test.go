@@ -0,0 +1,74 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func TestUniqueMailIgnoresCase(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+
+	assertCode(t, 409, svc.CreateAccount(serviceCtx(), &proto.CreateAccountRequest{Account: &proto.Account{
+		PreferredName:            "albert",
+		OnPremisesSamAccountName: "albert",
+		Mail:                     "Einstein@Example.org",
+		AccountEnabled:           true,
+	}}, &proto.Account{}))
+	assertCode(t, 409, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: marieID, Mail: "EINSTEIN@example.org"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"Mail"}},
+	}, &proto.Account{}))
+
+	// changing the case of the own mail is not a conflict
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: einsteinID, Mail: "Einstein@Example.org"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"Mail"}},
+	}, &proto.Account{}))
+
+	list := &proto.ListAccountsResponse{}
+	require.NoError(t, svc.ListAccounts(serviceCtx(), &proto.ListAccountsRequest{Query: "mail eq 'einstein@example.org'"}, list))
+	assert.Equal(t, []string{einsteinID}, accountIDs(list.Accounts))
+}
+
+func TestUniqueNames(t *testing.T) {
+	defer os.RemoveAll(authorizationDataPath)
+	svc := newAuthorizationService(t)
+	create := func(preferredName, samName string) error {
+		return svc.CreateAccount(serviceCtx(), &proto.CreateAccountRequest{Account: &proto.Account{
+			PreferredName:            preferredName,
+			OnPremisesSamAccountName: samName,
+			Mail:                     samName + "@example.net",
+			AccountEnabled:           true,
+		}}, &proto.Account{})
+	}
+
+	assertCode(t, 409, create("Einstein", "albert"))
+	assertCode(t, 409, create("albert", "EINSTEIN"))
+	require.NoError(t, create("albert", "albert"))
+
+	// deleted accounts keep their names, they might be restored
+	require.NoError(t, svc.DeleteAccount(serviceCtx(), &proto.DeleteAccountRequest{Id: marieID}, &empty.Empty{}))
+	assertCode(t, 409, create("marie", "curie"))
+
+	// updates only check the changed names
+	require.NoError(t, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: einsteinID, PreferredName: "einstein", DisplayName: "Albert"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"PreferredName", "DisplayName"}},
+	}, &proto.Account{}))
+	assertCode(t, 409, svc.UpdateAccount(serviceCtx(), &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: einsteinID, PreferredName: "Albert"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"PreferredName"}},
+	}, &proto.Account{}))
+
+	assertCode(t, 409, svc.CreateGroup(serviceCtx(), &proto.CreateGroupRequest{Group: &proto.Group{
+		OnPremisesSamAccountName: "Users",
+		DisplayName:              "Other users",
+	}}, &proto.Group{}))
+}
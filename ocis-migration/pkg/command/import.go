@@ -15,6 +15,7 @@ import (
 	"github.com/micro/cli/v2"
 	"github.com/micro/go-micro/registry"
 	"github.com/micro/go-micro/v2/client/grpc"
+	"github.com/refs/ocis-mono/ocis-accounts/pkg/auth"
 	accounts "github.com/refs/ocis-mono/ocis-accounts/pkg/proto/v0"
 	"github.com/refs/ocis-mono/ocis-migration/pkg/config"
 	"github.com/refs/ocis-mono/ocis-migration/pkg/flagset"
@@ -126,7 +127,8 @@ func Import(cfg *config.Config) *cli.Command {
 			})
 
 			logger.Debug().Msg("Creating entry in com.owncloud.accounts")
-			ss := accounts.NewAccountsService("com.owncloud.accounts", grpc.NewClient())
+			// the accounts service only trusts services that authenticate with a token
+			ss := accounts.NewAccountsService("com.owncloud.accounts", auth.ClientWrapper(c.String("accounts-jwt-secret"), "migration")(grpc.NewClient()))
 			_, err = ss.CreateAccount(c.Context, &accounts.CreateAccountRequest{
 				Account: &accounts.Account{
 					// TODO really use the old username as the uuid? it would be unique, but only in the scope of this instance. shouldn't we be able to roll a new uuid?
@@ -21,5 +21,11 @@ func ImportWithConfig(cfg *config.Config) []cli.Flag {
 			Usage:   "Used to create JWT to talk to reva, should equal reva's jwt-secret",
 			EnvVars: []string{"MIGRATION_JWT_SECRET"},
 		},
+		&cli.StringFlag{
+			Name:    "accounts-jwt-secret",
+			Value:   "Pive-Fumkiu4",
+			Usage:   "Used to sign the service tokens of the requests to the accounts service, should equal its jwt-secret",
+			EnvVars: []string{"MIGRATION_ACCOUNTS_JWT_SECRET"},
+		},
 	}
 }
@@ -23,7 +23,14 @@ func AccountsCommand(cfg *config.Config) *cli.Command {
 			command.AddAccount(cfg.Accounts),
 			command.UpdateAccount(cfg.Accounts),
 			command.RemoveAccount(cfg.Accounts),
+			command.RestoreAccount(cfg.Accounts),
 			command.InspectAccount(cfg.Accounts),
+			command.Check(cfg.Accounts),
+			command.Sync(cfg.Accounts),
+			command.APIKeys(cfg.Accounts),
+			command.RevokeSessions(cfg.Accounts),
+			command.Identities(cfg.Accounts),
+			command.InviteGuest(cfg.Accounts),
 		},
 		Action: func(c *cli.Context) error {
 			accountsCommand := command.Server(configureAccounts(cfg))
@@ -40,6 +47,9 @@ func configureAccounts(cfg *config.Config) *svcconfig.Config {
 	cfg.Accounts.Log.Level = cfg.Log.Level
 	cfg.Accounts.Log.Pretty = cfg.Log.Pretty
 	cfg.Accounts.Log.Color = cfg.Log.Color
+	if cfg.Accounts.Debug.Token == "" {
+		cfg.Accounts.Debug.Token = cfg.Debug.Token
+	}
 
 	return cfg.Accounts
 }